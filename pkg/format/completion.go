@@ -0,0 +1,86 @@
+package format
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// GetPropKeyCompletions returns the primary keys of the config query/param props that start with the prefix,
+// excluding the props that are set using any of the keys in the excluded list.
+func GetPropKeyCompletions(config types.ServiceConfig, prefix string, excluded []string) []string {
+	prefix = strings.ToLower(prefix)
+	completions := []string{}
+
+	for _, node := range GetConfigFormat(config).Items {
+		field := node.Field()
+		if len(field.Keys) < 1 || !strings.HasPrefix(field.Keys[0], prefix) {
+			continue
+		}
+
+		if containsAnyKey(excluded, field.Keys) {
+			continue
+		}
+
+		completions = append(completions, field.Keys[0])
+	}
+
+	return completions
+}
+
+// GetPropValueCompletions returns the known values of the query/param prop with the key (or alias) that start
+// with the prefix (ignoring case). The known values are the names of enum values, the boolean values and the
+// values allowed by a oneof validation rule.
+func GetPropValueCompletions(config types.ServiceConfig, key string, prefix string) []string {
+	field := getPropField(config, key)
+	if field == nil {
+		return []string{}
+	}
+
+	var values []string
+
+	switch {
+	case field.IsEnum():
+		values = field.EnumFormatter.Names()
+	case field.Type.Kind() == reflect.Bool:
+		values = []string{PrintBool(true), PrintBool(false)}
+	default:
+		values = field.Validation.OneOf
+	}
+
+	completions := []string{}
+
+	for _, value := range values {
+		if value != "" && strings.HasPrefix(strings.ToLower(value), strings.ToLower(prefix)) {
+			completions = append(completions, value)
+		}
+	}
+
+	return completions
+}
+
+// getPropField returns the field of the query/param prop with the key (or alias), or nil if there is none.
+func getPropField(config types.ServiceConfig, key string) *FieldInfo {
+	key = strings.ToLower(key)
+
+	for _, node := range GetConfigFormat(config).Items {
+		if field := node.Field(); slices.Contains(field.Keys, key) {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// containsAnyKey returns whether any of the keys is in the list, ignoring case.
+func containsAnyKey(list []string, keys []string) bool {
+	for _, item := range list {
+		if slices.Contains(keys, strings.ToLower(item)) {
+			return true
+		}
+	}
+
+	return false
+}
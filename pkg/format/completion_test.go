@@ -0,0 +1,72 @@
+package format
+
+import (
+	"net/url"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+type completionConfig struct {
+	Host     string `url:"host"`
+	Priority int    `default:"Normal" key:"priority,prio"`
+	Mode     string `default:"fast"   key:"mode"          oneof:"fast,slow"`
+	Markdown bool   `default:"No"     key:"markdown"`
+	Name     string `key:"name"       optional:""`
+}
+
+func (c *completionConfig) GetURL() *url.URL {
+	return &url.URL{Scheme: "mock", Host: c.Host}
+}
+
+func (c *completionConfig) SetURL(_ *url.URL) error {
+	return nil
+}
+
+func (c *completionConfig) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"Priority": CreateEnumFormatter([]string{"Low", "Normal", "High"}),
+	}
+}
+
+var _ = ginkgo.Describe("Prop completions", func() {
+	config := &completionConfig{}
+
+	ginkgo.Describe("completing keys", func() {
+		ginkgo.It("should return all primary keys for an empty prefix", func() {
+			gomega.Expect(GetPropKeyCompletions(config, "", nil)).
+				To(gomega.Equal([]string{"markdown", "mode", "name", "priority"}))
+		})
+		ginkgo.It("should only return the keys matching the prefix", func() {
+			gomega.Expect(GetPropKeyCompletions(config, "M", nil)).
+				To(gomega.Equal([]string{"markdown", "mode"}))
+		})
+		ginkgo.It("should exclude props that are already set using any key", func() {
+			gomega.Expect(GetPropKeyCompletions(config, "", []string{"PRIO", "mode"})).
+				To(gomega.Equal([]string{"markdown", "name"}))
+		})
+	})
+
+	ginkgo.Describe("completing values", func() {
+		ginkgo.It("should return the enum names matching the prefix", func() {
+			gomega.Expect(GetPropValueCompletions(config, "priority", "")).
+				To(gomega.Equal([]string{"Low", "Normal", "High"}))
+			gomega.Expect(GetPropValueCompletions(config, "prio", "n")).
+				To(gomega.Equal([]string{"Normal"}))
+		})
+		ginkgo.It("should return the boolean values", func() {
+			gomega.Expect(GetPropValueCompletions(config, "markdown", "")).
+				To(gomega.Equal([]string{"Yes", "No"}))
+		})
+		ginkgo.It("should return the values allowed by the oneof rule", func() {
+			gomega.Expect(GetPropValueCompletions(config, "mode", "S")).
+				To(gomega.Equal([]string{"slow"}))
+		})
+		ginkgo.It("should return nothing for free-form and unknown props", func() {
+			gomega.Expect(GetPropValueCompletions(config, "name", "")).To(gomega.BeEmpty())
+			gomega.Expect(GetPropValueCompletions(config, "unknown", "")).To(gomega.BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package format
+
+import (
+	"reflect"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+var (
+	// SchemeDiffField is the field of the FieldDiff that is returned if the configs use different URL schemes.
+	SchemeDiffField = &FieldInfo{Name: "Scheme"}
+
+	// URLDiffField is the field of the FieldDiff that is returned if the configs have different URLs, while none
+	// of their fields differ, e.g. since the URL is kept in unexported state.
+	URLDiffField = &FieldInfo{Name: "URL"}
+)
+
+// FieldDiff is a config field that has different values in two configs.
+type FieldDiff struct {
+	Field *FieldInfo
+	Left  string
+	Right string
+}
+
+// DiffConfigs returns the fields that have different values in the left and the right config, which must be
+// of the same type. The values are compared using their serialized form, so that equivalent values are equal.
+// Since configs can keep state that affects the notification target in unexported fields, the schemes and the
+// URLs of the configs are compared as well.
+func DiffConfigs(left types.ServiceConfig, right types.ServiceConfig) []FieldDiff {
+	leftValue := reflect.Indirect(reflect.ValueOf(left))
+	rightValue := reflect.Indirect(reflect.ValueOf(right))
+
+	diffs := []FieldDiff{}
+
+	for _, node := range GetConfigFormat(left).Items {
+		field := node.Field()
+
+		leftString, _ := GetConfigFieldString(leftValue, *field)
+		rightString, _ := GetConfigFieldString(rightValue, *field)
+
+		if leftString != rightString {
+			diffs = append(diffs, FieldDiff{Field: field, Left: leftString, Right: rightString})
+		}
+	}
+
+	leftURL := left.GetURL()
+	rightURL := right.GetURL()
+
+	if leftURL.Scheme != rightURL.Scheme {
+		diffs = append(diffs, FieldDiff{Field: SchemeDiffField, Left: leftURL.Scheme, Right: rightURL.Scheme})
+	}
+
+	if len(diffs) == 0 && leftURL.String() != rightURL.String() {
+		diffs = append(diffs, FieldDiff{Field: URLDiffField, Left: leftURL.String(), Right: rightURL.String()})
+	}
+
+	return diffs
+}
@@ -0,0 +1,94 @@
+package format
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// EnvPrefix is the prefix used for environment variables containing service config values.
+const EnvPrefix = "SHOUTRRR"
+
+// LookupEnvFunc returns the value of the environment variable with the specified name, and whether it was set.
+type LookupEnvFunc func(name string) (string, bool)
+
+// GetEnvVarNames returns the environment variable names that can be used for the field, which are the prefix
+// followed by either the upper case field name (e.g. PREFIX_PARSEMODE) or the upper snake case field name
+// (e.g. PREFIX_PARSE_MODE).
+func GetEnvVarNames(prefix string, field *FieldInfo) []string {
+	upperName := prefix + "_" + strings.ToUpper(field.Name)
+	snakeName := prefix + "_" + toUpperSnakeCase(field.Name)
+
+	if snakeName == upperName {
+		return []string{upperName}
+	}
+
+	return []string{upperName, snakeName}
+}
+
+// GetEnvProps returns the field values of the config, keyed by field name, from the environment variables that
+// are named using the prefix and the field names (see GetEnvVarNames). The values are serialized the same way
+// as in service URLs, with list items and map entries separated by the field item separator.
+// If lookupEnv is nil, os.LookupEnv is used.
+func GetEnvProps(config types.ServiceConfig, prefix string, lookupEnv LookupEnvFunc) map[string]string {
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+
+	props := map[string]string{}
+
+	for _, node := range GetConfigFormat(config).Items {
+		field := node.Field()
+
+		for _, name := range GetEnvVarNames(prefix, field) {
+			if value, found := lookupEnv(name); found {
+				props[field.Name] = value
+
+				break
+			}
+		}
+	}
+
+	return props
+}
+
+// BuildURLFromEnv returns the service URL for a config created from the environment variables that are named
+// using the prefix and the field names (see GetEnvVarNames), using BuildURL.
+func BuildURLFromEnv(config types.ServiceConfig, prefix string, lookupEnv LookupEnvFunc) (*url.URL, error) {
+	props := GetEnvProps(config, prefix, lookupEnv)
+	if len(props) < 1 {
+		return nil, fmt.Errorf("no config values found in environment variables with the prefix %s_", prefix)
+	}
+
+	serviceURL, err := BuildURL(config, props)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config in environment variables with the prefix %s_: %w", prefix, err)
+	}
+
+	return serviceURL, nil
+}
+
+// toUpperSnakeCase converts a field name like "ParseMode" or "APIKey" to "PARSE_MODE" and "API_KEY".
+func toUpperSnakeCase(name string) string {
+	runes := []rune(name)
+	sb := strings.Builder{}
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				sb.WriteRune('_')
+			}
+		}
+
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,44 @@
+package format
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("Environment variable props", func() {
+	lookupMap := func(env map[string]string) LookupEnvFunc {
+		return func(name string) (string, bool) {
+			value, found := env[name]
+
+			return value, found
+		}
+	}
+
+	ginkgo.DescribeTable("getting the variable names of a field",
+		func(fieldName string, expected []string) {
+			gomega.Expect(GetEnvVarNames("PREFIX", &FieldInfo{Name: fieldName})).To(gomega.Equal(expected))
+		},
+		ginkgo.Entry("single word", "Token", []string{"PREFIX_TOKEN"}),
+		ginkgo.Entry("multiple words", "ParseMode", []string{"PREFIX_PARSEMODE", "PREFIX_PARSE_MODE"}),
+		ginkgo.Entry("leading acronym", "APIKey", []string{"PREFIX_APIKEY", "PREFIX_API_KEY"}),
+		ginkgo.Entry("trailing acronym", "DisableTLS", []string{"PREFIX_DISABLETLS", "PREFIX_DISABLE_TLS"}),
+	)
+
+	ginkgo.When("getting props from the environment", func() {
+		ginkgo.It("should only return the fields that are set", func() {
+			props := GetEnvProps(&testStruct{}, "TEST", lookupMap(map[string]string{
+				"TEST_STR":       "value",
+				"TEST_STR_SLICE": "a,b",
+				"TEST_UNRELATED": "x",
+			}))
+			gomega.Expect(props).To(gomega.Equal(map[string]string{"Str": "value", "StrSlice": "a,b"}))
+		})
+	})
+
+	ginkgo.When("building a URL from the environment", func() {
+		ginkgo.It("should return an error if no variables are set", func() {
+			_, err := BuildURLFromEnv(&testStruct{}, "TEST", lookupMap(map[string]string{}))
+			gomega.Expect(err).To(gomega.MatchError("no config values found in environment variables with the prefix TEST_"))
+		})
+	})
+})
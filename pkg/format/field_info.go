@@ -26,6 +26,9 @@ type FieldInfo struct {
 	Base          int
 	Keys          []string
 	ItemSeparator rune
+	Validation    FieldValidation
+	Secret        bool
+	Source        FieldSource
 }
 
 // IsEnum returns whether a EnumFormatter has been assigned to the field and that it is of a suitable type.
@@ -86,6 +89,10 @@ func getStructFieldInfo(structType reflect.Type, enums map[string]types.EnumForm
 			info.Required = false
 		}
 
+		if _, ok := fieldDef.Tag.Lookup("secret"); ok {
+			info.Secret = true
+		}
+
 		if _, ok := fieldDef.Tag.Lookup("title"); ok {
 			info.Title = true
 		}
@@ -103,6 +110,11 @@ func getStructFieldInfo(structType reflect.Type, enums map[string]types.EnumForm
 			info.ItemSeparator = rune(tag[0])
 		}
 
+		info.Validation = getFieldValidation(fieldDef)
+		if info.Validation.Required {
+			info.Required = true
+		}
+
 		if ef, isEnum := enums[fieldDef.Name]; isEnum {
 			info.EnumFormatter = ef
 		}
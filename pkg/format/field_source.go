@@ -0,0 +1,144 @@
+package format
+
+import (
+	"net/url"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// FieldSource is where the value of a config field was set from.
+type FieldSource string
+
+// The sources of config field values, in increasing order of precedence (after SourceUnknown).
+const (
+	// SourceUnknown is used for fields whose source has not been resolved.
+	SourceUnknown FieldSource = ""
+	// SourceService is used for fields that were not set by any of the inputs, but that the service set to a
+	// value other than the default, like values derived from other fields.
+	SourceService FieldSource = "service"
+	// SourceDefault is used for fields that were not set by any of the inputs, and still have their default value.
+	SourceDefault FieldSource = "default"
+	// SourceURL is used for fields set by the URL parts or query of the service URL.
+	SourceURL FieldSource = "url"
+	// SourceEnv is used for fields set by environment variables (see GetEnvProps).
+	SourceEnv FieldSource = "env"
+	// SourceParam is used for query/param props overridden by params.
+	SourceParam FieldSource = "param"
+)
+
+// FieldSources are the inputs that a config was created from, used to resolve the source of each field.
+type FieldSources struct {
+	// URL is the service URL that the config was parsed from
+	URL *url.URL
+	// EnvProps are the field values read from environment variables, keyed by field name
+	EnvProps map[string]string
+	// Params are the params that were applied to the config after parsing the URL
+	Params *types.Params
+}
+
+// SetFieldSources sets the Source of the fields of the config node tree, based on which of the inputs contained
+// a value for the field. Fields that none of the inputs contained a value for are reported as having their
+// default value if the config value matches it, and as set by the service otherwise.
+func SetFieldSources(root *ContainerNode, config types.ServiceConfig, sources FieldSources) {
+	var queryKeys []string
+
+	if sources.URL != nil {
+		for key := range sources.URL.Query() {
+			queryKeys = append(queryKeys, strings.ToLower(key))
+		}
+	}
+
+	var paramKeys []string
+
+	if sources.Params != nil {
+		for key := range *sources.Params {
+			paramKeys = append(paramKeys, strings.ToLower(key))
+		}
+	}
+
+	pkr := NewPropKeyResolver(config)
+	configValue := reflect.Indirect(reflect.ValueOf(config))
+
+	for _, node := range root.Items {
+		field := node.Field()
+		_, fromEnv := sources.EnvProps[field.Name]
+
+		switch {
+		case len(field.Keys) > 0 && containsAnyKey(paramKeys, field.Keys):
+			field.Source = SourceParam
+		case fromEnv:
+			field.Source = SourceEnv
+		case len(field.Keys) > 0 && containsAnyKey(queryKeys, field.Keys):
+			field.Source = SourceURL
+		case sources.URL != nil && hasURLPartValue(sources.URL, field.URLParts):
+			field.Source = SourceURL
+		default:
+			field.Source = getUnsetFieldSource(&pkr, configValue, field)
+		}
+	}
+}
+
+// getUnsetFieldSource returns the source of a field that was not set by any of the inputs.
+func getUnsetFieldSource(pkr *PropKeyResolver, configValue reflect.Value, field *FieldInfo) FieldSource {
+	value, err := GetConfigFieldString(configValue, *field)
+	if err != nil {
+		return SourceUnknown
+	}
+
+	if value == field.DefaultValue || (len(field.Keys) > 0 && pkr.IsDefault(strings.ToLower(field.Keys[0]), value)) {
+		return SourceDefault
+	}
+
+	return SourceService
+}
+
+// hasURLPartValue returns whether any of the URL parts are non-empty in the URL.
+func hasURLPartValue(serviceURL *url.URL, parts []URLPart) bool {
+	segments := strings.Split(strings.Trim(serviceURL.Path, "/"), "/")
+
+	for _, part := range parts {
+		var value string
+
+		switch {
+		case part == URLQuery:
+			continue
+		case part == URLUser && serviceURL.User != nil:
+			value = serviceURL.User.Username()
+		case part == URLPassword && serviceURL.User != nil:
+			value, _ = serviceURL.User.Password()
+		case part == URLHost:
+			value = serviceURL.Hostname()
+		case part == URLPort:
+			value = serviceURL.Port()
+		case part >= URLPath && int(part-URLPath) < len(segments):
+			value = segments[part-URLPath]
+		}
+
+		if value != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetUnknownQueryKeys returns the keys of the query that are not config props, sorted alphabetically.
+// Depending on the service, these are either ignored or passed on as is when the URL is parsed.
+func GetUnknownQueryKeys(config types.ServiceConfig, query url.Values) []string {
+	validKeys := GetConfigQueryResolver(config).QueryFields()
+	unknownKeys := []string{}
+
+	for key := range query {
+		if !slices.Contains(validKeys, strings.ToLower(key)) {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+
+	sort.Strings(unknownKeys)
+
+	return unknownKeys
+}
@@ -0,0 +1,106 @@
+package format
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+var _ = ginkgo.Describe("Field sources", func() {
+	// parseConfig returns the config parsed from the URL of the sources, with the params applied.
+	parseConfig := func(sources FieldSources) *normalizeConfig {
+		config, err := ParseConfig(&normalizeConfig{}, sources.URL)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		pkr := NewPropKeyResolver(config)
+		gomega.Expect(pkr.UpdateConfigFromParams(config, sources.Params)).To(gomega.Succeed())
+
+		return config.(*normalizeConfig)
+	}
+
+	getConfigSources := func(config *normalizeConfig, sources FieldSources) map[string]FieldSource {
+		root := GetConfigFormat(config)
+		SetFieldSources(root, config, sources)
+
+		fieldSources := map[string]FieldSource{}
+		for _, node := range root.Items {
+			fieldSources[node.Field().Name] = node.Field().Source
+		}
+
+		return fieldSources
+	}
+
+	getSources := func(sources FieldSources) map[string]FieldSource {
+		return getConfigSources(parseConfig(sources), sources)
+	}
+
+	ginkgo.It("should use the URL for URL parts and query props present in the query", func() {
+		sources := getSources(FieldSources{URL: parseURL("mock://example.com?LVL=2")})
+		gomega.Expect(sources).To(gomega.Equal(map[string]FieldSource{
+			"Host":  SourceURL,
+			"Level": SourceURL,
+			"Color": SourceDefault,
+			"Name":  SourceDefault,
+			"Tags":  SourceDefault,
+		}))
+	})
+	ginkgo.It("should let params take precedence over the URL and env", func() {
+		sources := getSources(FieldSources{
+			URL:      parseURL("mock://example.com?level=2&name=a"),
+			EnvProps: map[string]string{"Host": "example.com", "Name": "b", "Tags": "c"},
+			Params:   &types.Params{"name": "c", "color": "0x10"},
+		})
+		gomega.Expect(sources).To(gomega.Equal(map[string]FieldSource{
+			"Host":  SourceEnv,
+			"Level": SourceURL,
+			"Color": SourceParam,
+			"Name":  SourceParam,
+			"Tags":  SourceEnv,
+		}))
+	})
+	ginkgo.It("should only use the URL for URL parts that are not empty", func() {
+		sources := getSources(FieldSources{URL: parseURL("mock:///?level=2")})
+		gomega.Expect(sources).To(gomega.HaveKeyWithValue("Host", SourceDefault))
+	})
+	ginkgo.It("should use the service for values that are not the default and not set by any input", func() {
+		sources := FieldSources{URL: parseURL("mock://example.com")}
+		config := parseConfig(sources)
+		config.Level = 3
+		config.Name = "derived"
+
+		gomega.Expect(getConfigSources(config, sources)).To(gomega.Equal(map[string]FieldSource{
+			"Host":  SourceURL,
+			"Level": SourceService,
+			"Color": SourceDefault,
+			"Name":  SourceService,
+			"Tags":  SourceDefault,
+		}))
+	})
+	ginkgo.It("should be rendered by the console and JSON renderers", func() {
+		sources := FieldSources{URL: parseURL("mock://example.com?level=2")}
+		config := parseConfig(sources)
+		root := GetConfigFormat(config)
+		SetFieldSources(root, config, sources)
+
+		gomega.Expect(ConsoleTreeRenderer{WithValues: true}.RenderTree(root, "mock")).
+			To(gomega.ContainSubstring("<Source: url>"))
+		gomega.Expect(ConsoleTreeRenderer{WithValues: false}.RenderTree(root, "mock")).
+			NotTo(gomega.ContainSubstring("<Source:"))
+
+		fields := JSONTreeRenderer{WithValues: true}.ServiceConfig(root, "mock").Fields
+		gomega.Expect(fields[0].Name).To(gomega.Equal("Color"))
+		gomega.Expect(fields[0].Source).To(gomega.Equal("default"))
+	})
+
+	ginkgo.Describe("getting unknown query keys", func() {
+		ginkgo.It("should return the keys that are not config props", func() {
+			query := parseURL("mock://example.com?lvl=2&Name=a&foo=1&Bar=2").Query()
+			gomega.Expect(GetUnknownQueryKeys(&normalizeConfig{}, query)).To(gomega.Equal([]string{"Bar", "foo"}))
+		})
+		ginkgo.It("should return an empty list if all keys are config props", func() {
+			query := parseURL("mock://example.com?level=2").Query()
+			gomega.Expect(GetUnknownQueryKeys(&normalizeConfig{}, query)).To(gomega.BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,303 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util"
+)
+
+// Value formats that can be used with the format tag.
+const (
+	FormatEmail    = "email"
+	FormatURL      = "url"
+	FormatDuration = "duration"
+)
+
+// FieldValidation contains the validation rules of a config field, as specified by its tags:
+//
+//	required:""            the value cannot be empty
+//	pattern:"^[a-z]+$"     the value (or each item) must match the regular expression
+//	min:"1" max:"10"       the value of numbers, or the length of strings and the item count of lists, must be in range
+//	oneof:"a,b,c"          the value (or each item) must be one of the comma separated values
+//	format:"email"         the value (or each item) must be an e-mail address, an absolute URL (url) or a duration
+type FieldValidation struct {
+	Required bool
+	Pattern  string
+	Min      *float64
+	Max      *float64
+	OneOf    []string
+	Format   string
+}
+
+// IsEmpty returns whether the field does not have any validation rules.
+func (fv FieldValidation) IsEmpty() bool {
+	return !fv.Required && fv.Pattern == "" && fv.Min == nil && fv.Max == nil && len(fv.OneOf) < 1 && fv.Format == ""
+}
+
+// Rules returns a human readable description of each of the validation rules.
+func (fv FieldValidation) Rules() []string {
+	rules := []string{}
+
+	if fv.Pattern != "" {
+		rules = append(rules, fmt.Sprintf("pattern: %s", fv.Pattern))
+	}
+
+	if fv.Min != nil {
+		rules = append(rules, fmt.Sprintf("min: %v", *fv.Min))
+	}
+
+	if fv.Max != nil {
+		rules = append(rules, fmt.Sprintf("max: %v", *fv.Max))
+	}
+
+	if len(fv.OneOf) > 0 {
+		rules = append(rules, fmt.Sprintf("one of: %s", strings.Join(fv.OneOf, ", ")))
+	}
+
+	if fv.Format != "" {
+		rules = append(rules, fmt.Sprintf("format: %s", fv.Format))
+	}
+
+	return rules
+}
+
+func getFieldValidation(fieldDef reflect.StructField) FieldValidation {
+	validation := FieldValidation{}
+
+	if _, ok := fieldDef.Tag.Lookup("required"); ok {
+		validation.Required = true
+	}
+
+	if tag, ok := fieldDef.Tag.Lookup("pattern"); ok {
+		validation.Pattern = tag
+	}
+
+	if tag, ok := fieldDef.Tag.Lookup("min"); ok {
+		if value, err := strconv.ParseFloat(tag, 64); err == nil {
+			validation.Min = &value
+		}
+	}
+
+	if tag, ok := fieldDef.Tag.Lookup("max"); ok {
+		if value, err := strconv.ParseFloat(tag, 64); err == nil {
+			validation.Max = &value
+		}
+	}
+
+	if tag, ok := fieldDef.Tag.Lookup("oneof"); ok {
+		validation.OneOf = strings.Split(tag, ",")
+	}
+
+	if tag, ok := fieldDef.Tag.Lookup("format"); ok {
+		validation.Format = strings.ToLower(tag)
+	}
+
+	return validation
+}
+
+// ValidateFieldValue returns an error if the serialized value of the field does not satisfy its validation rules.
+func ValidateFieldValue(field *FieldInfo, value string) error {
+	validation := field.Validation
+	if validation.IsEmpty() {
+		return nil
+	}
+
+	if value == "" {
+		if validation.Required {
+			return fmt.Errorf("%s is required", field.Name)
+		}
+
+		// Only required fields are validated when empty
+		return nil
+	}
+
+	if err := validateFieldValue(field, value); err != nil {
+		return fmt.Errorf("invalid value for %s: %w", field.Name, err)
+	}
+
+	return nil
+}
+
+func validateFieldValue(field *FieldInfo, value string) error {
+	validation := field.Validation
+	kind := field.Type.Kind()
+
+	items := []string{value}
+	if (kind == reflect.Slice || kind == reflect.Array) && field.ItemSeparator != 0 {
+		items = strings.Split(value, string(field.ItemSeparator))
+	}
+
+	if err := validateRange(field, value, len(items)); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if err := validateItem(validation, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateRange checks the min and max rules against the value of numbers, the length of strings or the
+// item count of lists.
+func validateRange(field *FieldInfo, value string, itemCount int) error {
+	validation := field.Validation
+	if validation.Min == nil && validation.Max == nil {
+		return nil
+	}
+
+	var (
+		size float64
+		what string
+	)
+
+	vt, isValueType := getValueType(field.Type)
+
+	switch kind := field.Type.Kind(); {
+	case isValueType && vt.size != nil:
+		parsed, err := vt.parse(value)
+		if err != nil {
+			return err
+		}
+
+		size, what = vt.size(parsed)
+	case util.IsNumeric(kind):
+		number, base := util.StripNumberPrefix(value)
+
+		if base == 0 || base == DefaultBase {
+			parsed, err := strconv.ParseFloat(number, 64)
+			if err != nil {
+				return fmt.Errorf("%q is not a number", value)
+			}
+
+			size = parsed
+		} else {
+			parsed, err := strconv.ParseInt(number, base, 64)
+			if err != nil {
+				return fmt.Errorf("%q is not a number", value)
+			}
+
+			size = float64(parsed)
+		}
+
+		what = "value"
+	case kind == reflect.Slice || kind == reflect.Array:
+		size = float64(itemCount)
+		what = "item count"
+	default:
+		size = float64(len([]rune(value)))
+		what = "length"
+	}
+
+	if validation.Min != nil && size < *validation.Min {
+		return fmt.Errorf("%s must be at least %v", what, *validation.Min)
+	}
+
+	if validation.Max != nil && size > *validation.Max {
+		return fmt.Errorf("%s must be at most %v", what, *validation.Max)
+	}
+
+	return nil
+}
+
+// compiledPatterns caches the compiled validation patterns, since the field info is recreated for each config.
+var compiledPatterns sync.Map
+
+// compiledPattern is the result of compiling a validation pattern.
+type compiledPattern struct {
+	regexp *regexp.Regexp
+	err    error
+}
+
+// compilePattern returns the compiled regular expression for the pattern, only compiling each pattern once.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if cached, found := compiledPatterns.Load(pattern); found {
+		compiled := cached.(compiledPattern)
+
+		return compiled.regexp, compiled.err
+	}
+
+	compiled := compiledPattern{}
+	compiled.regexp, compiled.err = regexp.Compile(pattern)
+	compiledPatterns.Store(pattern, compiled)
+
+	return compiled.regexp, compiled.err
+}
+
+func validateItem(validation FieldValidation, item string) error {
+	if validation.Pattern != "" {
+		pattern, err := compilePattern(validation.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid validation pattern %q: %w", validation.Pattern, err)
+		}
+
+		if !pattern.MatchString(item) {
+			return fmt.Errorf("%q does not match the pattern %s", item, validation.Pattern)
+		}
+	}
+
+	if len(validation.OneOf) > 0 && !slices.Contains(validation.OneOf, item) {
+		return fmt.Errorf("%q is not one of %s", item, strings.Join(validation.OneOf, ", "))
+	}
+
+	switch validation.Format {
+	case "":
+	case FormatEmail:
+		if address, err := mail.ParseAddress(item); err != nil || address.Address != item {
+			return fmt.Errorf("%q is not a valid e-mail address", item)
+		}
+	case FormatURL:
+		if parsed, err := url.Parse(item); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return fmt.Errorf("%q is not a valid absolute URL", item)
+		}
+	case FormatDuration:
+		if _, err := time.ParseDuration(item); err != nil {
+			return fmt.Errorf("%q is not a valid duration", item)
+		}
+	default:
+		return fmt.Errorf("unknown validation format %q", validation.Format)
+	}
+
+	return nil
+}
+
+// ValidateConfig returns an error for each of the config fields that does not satisfy its validation rules.
+func ValidateConfig(config types.ServiceConfig) error {
+	configNode := GetConfigFormat(config)
+	configValue := reflect.Indirect(reflect.ValueOf(config))
+
+	errs := []error{}
+
+	for _, node := range configNode.Items {
+		field := node.Field()
+		if field.Validation.IsEmpty() {
+			continue
+		}
+
+		value, err := GetConfigFieldString(configValue, *field)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid value for %s: %w", field.Name, err))
+
+			continue
+		}
+
+		if err := ValidateFieldValue(field, value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,202 @@
+package format
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+type validatedConfig struct {
+	Host     string   `pattern:"^[a-z.]+$" url:"host"`
+	Topic    string   `max:"8"             min:"2"        required:""       url:"path"`
+	Level    int      `default:"1"         key:"level"    max:"5"           min:"1"`
+	Mode     string   `default:"fast"      key:"mode"     oneof:"fast,slow"`
+	Email    string   `format:"email"      key:"email"    optional:""`
+	Link     string   `format:"url"        key:"link"     optional:""`
+	Delay    string   `format:"duration"   key:"delay"    optional:""`
+	Contacts []string `format:"email"      key:"contacts" max:"2"           optional:""`
+}
+
+func (c *validatedConfig) GetURL() *url.URL {
+	return &url.URL{Scheme: "mock", Host: c.Host, Path: c.Topic}
+}
+
+func (c *validatedConfig) SetURL(serviceURL *url.URL) error {
+	c.Host = serviceURL.Host
+	c.Topic = strings.TrimPrefix(serviceURL.Path, "/")
+
+	return nil
+}
+
+func (c *validatedConfig) Enums() map[string]types.EnumFormatter {
+	return nil
+}
+
+var _ = ginkgo.Describe("Field validation", func() {
+	getField := func(name string) *FieldInfo {
+		for _, node := range GetConfigFormat(&validatedConfig{}).Items {
+			if node.Field().Name == name {
+				return node.Field()
+			}
+		}
+
+		ginkgo.Fail("field not found: " + name)
+
+		return nil
+	}
+
+	ginkgo.Describe("parsing the validation tags", func() {
+		ginkgo.It("should set the rules of the field", func() {
+			field := getField("Level")
+			gomega.Expect(*field.Validation.Min).To(gomega.Equal(1.0))
+			gomega.Expect(*field.Validation.Max).To(gomega.Equal(5.0))
+			gomega.Expect(getField("Mode").Validation.OneOf).To(gomega.Equal([]string{"fast", "slow"}))
+			gomega.Expect(getField("Email").Validation.Format).To(gomega.Equal(FormatEmail))
+			gomega.Expect(getField("Host").Validation.Pattern).To(gomega.Equal("^[a-z.]+$"))
+		})
+		ginkgo.It("should mark fields with the required tag as required", func() {
+			field := getField("Topic")
+			gomega.Expect(field.Required).To(gomega.BeTrue())
+			gomega.Expect(field.Validation.Required).To(gomega.BeTrue())
+		})
+	})
+
+	ginkgo.DescribeTable("validating field values",
+		func(fieldName string, value string, errMatcher gomega.OmegaMatcher) {
+			err := ValidateFieldValue(getField(fieldName), value)
+			if errMatcher == nil {
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			} else {
+				gomega.Expect(err).To(gomega.MatchError(errMatcher))
+			}
+		},
+		ginkgo.Entry("matching pattern", "Host", "example.com", nil),
+		ginkgo.Entry("non-matching pattern", "Host", "Example.com", gomega.ContainSubstring("does not match the pattern")),
+		ginkgo.Entry("empty required value", "Topic", "", gomega.Equal("Topic is required")),
+		ginkgo.Entry("too short string", "Topic", "a", gomega.Equal("invalid value for Topic: length must be at least 2")),
+		ginkgo.Entry("too long string", "Topic", "abcdefghi", gomega.Equal("invalid value for Topic: length must be at most 8")),
+		ginkgo.Entry("number in range", "Level", "5", nil),
+		ginkgo.Entry("number out of range", "Level", "6", gomega.Equal("invalid value for Level: value must be at most 5")),
+		ginkgo.Entry("invalid number", "Level", "many", gomega.ContainSubstring("is not a number")),
+		ginkgo.Entry("allowed value", "Mode", "slow", nil),
+		ginkgo.Entry("disallowed value", "Mode", "medium", gomega.ContainSubstring("is not one of fast, slow")),
+		ginkgo.Entry("empty optional value", "Email", "", nil),
+		ginkgo.Entry("valid e-mail", "Email", "user@example.com", nil),
+		ginkgo.Entry("e-mail with display name", "Email", "User <user@example.com>", gomega.ContainSubstring("not a valid e-mail address")),
+		ginkgo.Entry("valid url", "Link", "https://example.com/path", nil),
+		ginkgo.Entry("relative url", "Link", "/path", gomega.ContainSubstring("not a valid absolute URL")),
+		ginkgo.Entry("valid duration", "Delay", "1h30m", nil),
+		ginkgo.Entry("invalid duration", "Delay", "tomorrow", gomega.ContainSubstring("not a valid duration")),
+		ginkgo.Entry("valid list items", "Contacts", "a@example.com,b@example.com", nil),
+		ginkgo.Entry("invalid list item", "Contacts", "a@example.com,b", gomega.ContainSubstring(`"b" is not a valid e-mail address`)),
+		ginkgo.Entry("too many list items", "Contacts", "a@example.com,b@example.com,c@example.com",
+			gomega.Equal("invalid value for Contacts: item count must be at most 2")),
+	)
+
+	ginkgo.Describe("validating a config", func() {
+		ginkgo.It("should succeed for a valid config", func() {
+			config := &validatedConfig{Host: "example.com", Topic: "topic", Level: 3, Mode: "fast"}
+			gomega.Expect(ValidateConfig(config)).To(gomega.Succeed())
+		})
+		ginkgo.It("should report every invalid field", func() {
+			config := &validatedConfig{Host: "Example", Level: 9, Mode: "fast"}
+			err := ValidateConfig(config)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("invalid value for Host"))
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("Topic is required"))
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("invalid value for Level"))
+		})
+	})
+
+	ginkgo.Describe("setting props using the PropKeyResolver", func() {
+		ginkgo.It("should reject values that do not satisfy the validation rules", func() {
+			config := &validatedConfig{}
+			pkr := NewPropKeyResolver(config)
+			gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+			gomega.Expect(pkr.Set("level", "0")).To(gomega.MatchError("invalid value for Level: value must be at least 1"))
+			gomega.Expect(config.Level).To(gomega.Equal(1))
+			gomega.Expect(pkr.Set("level", "2")).To(gomega.Succeed())
+			gomega.Expect(config.Level).To(gomega.Equal(2))
+		})
+	})
+
+	ginkgo.Describe("parsing a config URL", func() {
+		ginkgo.It("should succeed for a valid URL", func() {
+			config, err := ParseConfig(&validatedConfig{}, parseURL("mock://example.com/topic"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(config.(*validatedConfig).Topic).To(gomega.Equal("topic"))
+		})
+		ginkgo.It("should validate the fields that are set from the URL parts", func() {
+			_, err := ParseConfig(&validatedConfig{}, parseURL("mock://Example.com"))
+			gomega.Expect(err).To(gomega.MatchError(gomega.HavePrefix("invalid config: ")))
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("invalid value for Host"))
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("Topic is required"))
+		})
+	})
+
+	ginkgo.Describe("updating a config from params", func() {
+		ginkgo.It("should validate the updated config", func() {
+			config := &validatedConfig{Host: "Example.com", Topic: "topic"}
+			pkr := NewPropKeyResolver(config)
+			gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+
+			err := pkr.UpdateConfigFromParams(config, &types.Params{"level": "2"})
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("invalid value for Host")))
+		})
+		ginkgo.It("should validate the internal config if no config is provided", func() {
+			config := &validatedConfig{Host: "example.com"}
+			pkr := NewPropKeyResolver(config)
+			gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+
+			err := pkr.UpdateConfigFromParams(nil, &types.Params{"level": "2"})
+			gomega.Expect(err).To(gomega.MatchError("Topic is required"))
+		})
+		ginkgo.It("should not validate the config when there are no params", func() {
+			config := &validatedConfig{}
+			pkr := NewPropKeyResolver(config)
+			gomega.Expect(pkr.UpdateConfigFromParams(config, nil)).To(gomega.Succeed())
+		})
+	})
+
+	ginkgo.Describe("compiling the validation patterns", func() {
+		ginkgo.It("should only compile each pattern once", func() {
+			first, err := compilePattern("^[a-z]+$")
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			second, err := compilePattern("^[a-z]+$")
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(second).To(gomega.BeIdenticalTo(first))
+		})
+		ginkgo.It("should return the error for invalid patterns", func() {
+			_, err := compilePattern("[a-z")
+			gomega.Expect(err).To(gomega.HaveOccurred())
+
+			_, err = compilePattern("[a-z")
+			gomega.Expect(err).To(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Describe("rendering the validation rules", func() {
+		ginkgo.It("should include them in the JSON Schema", func() {
+			schema := JSONSchemaTreeRenderer{}.Schema(GetConfigFormat(&validatedConfig{}), "mock")
+			query := schema.Properties["query"].Properties
+			gomega.Expect(*query["level"].Minimum).To(gomega.Equal(1.0))
+			gomega.Expect(query["mode"].Enum).To(gomega.Equal([]string{"fast", "slow"}))
+			gomega.Expect(query["email"].Format).To(gomega.Equal("email"))
+			gomega.Expect(query["link"].Format).To(gomega.Equal("uri"))
+			gomega.Expect(query["delay"].ValueFormat).To(gomega.Equal(FormatDuration))
+			gomega.Expect(*query["contacts"].MaxItems).To(gomega.Equal(2))
+			gomega.Expect(query["contacts"].Items.Format).To(gomega.Equal("email"))
+			gomega.Expect(*schema.Properties["url"].Properties["Topic"].MaxLength).To(gomega.Equal(8))
+		})
+		ginkgo.It("should include them in the markdown docs", func() {
+			actual := testRenderTree(MarkdownTreeRenderer{HeaderPrefix: `### `}, &validatedConfig{})
+			gomega.Expect(actual).To(gomega.ContainSubstring("  Validation: `min: 1`, `max: 5`  \n"))
+			gomega.Expect(actual).To(gomega.ContainSubstring("  Validation: `one of: fast, slow`  \n"))
+		})
+	})
+})
@@ -61,7 +61,16 @@ func SetConfigField(config reflect.Value, field FieldInfo, inputValue string) (v
 	configField := config.FieldByName(field.Name)
 	fieldKind := field.Type.Kind()
 
-	if fieldKind == reflect.String {
+	if vt, isValueType := getValueType(field.Type); isValueType {
+		value, err := vt.parse(inputValue)
+		if err != nil {
+			return false, err
+		}
+
+		configField.Set(value)
+
+		return true, nil
+	} else if fieldKind == reflect.String {
 		configField.SetString(inputValue)
 
 		return true, nil
@@ -106,6 +115,11 @@ func SetConfigField(config reflect.Value, field FieldInfo, inputValue string) (v
 
 		configField.SetBool(value)
 
+		return true, nil
+	} else if inputValue == "" && (fieldKind == reflect.Map || isStructSlice(field.Type)) {
+		// Empty values cannot be parsed as key:value pairs or props, so they are treated as having no items
+		configField.Set(reflect.Zero(field.Type))
+
 		return true, nil
 	} else if fieldKind == reflect.Map {
 		keyKind := field.Type.Key().Kind()
@@ -213,6 +227,20 @@ func SetConfigField(config reflect.Value, field FieldInfo, inputValue string) (v
 	return false, err
 }
 
+// isStructSlice returns whether the type is a slice or array of (pointers to) structs.
+func isStructSlice(fieldType reflect.Type) bool {
+	if fieldType.Kind() != reflect.Slice && fieldType.Kind() != reflect.Array {
+		return false
+	}
+
+	elemType := fieldType.Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+
+	return elemType.Kind() == reflect.Struct
+}
+
 func getMapValue(valueType reflect.Type, valueRaw string) (reflect.Value, error) {
 	kind := valueType.Kind()
 	switch kind {
@@ -74,6 +74,14 @@ var _ = ginkgo.Describe("SetConfigField", func() {
 					gomega.Expect(ts.StrSlice).To(gomega.HaveLen(2))
 				})
 			})
+			ginkgo.When("the value is empty", func() {
+				ginkgo.It("should set a single empty item", func() {
+					valid, err := SetConfigField(tv, *nodeMap["StrSlice"].Field(), "")
+					gomega.Expect(valid).To(gomega.BeTrue())
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					gomega.Expect(ts.StrSlice).To(gomega.Equal([]string{""}))
+				})
+			})
 		})
 		ginkgo.When("setting a string array value", func() {
 			ginkgo.When("the value is valid", func() {
@@ -133,6 +141,26 @@ var _ = ginkgo.Describe("SetConfigField", func() {
 					gomega.Expect(ts.SubPropSlice).To(gomega.HaveLen(2))
 				})
 			})
+			ginkgo.When("the value is empty", func() {
+				ginkgo.It("should not set any items", func() {
+					ts.SubPropSlice = []subPropStruct{{}}
+					valid, err := SetConfigField(tv, *nodeMap["SubPropSlice"].Field(), "")
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					gomega.Expect(valid).To(gomega.BeTrue())
+					gomega.Expect(ts.SubPropSlice).To(gomega.BeEmpty())
+				})
+			})
+		})
+		ginkgo.When("setting a map value", func() {
+			ginkgo.When("the value is empty", func() {
+				ginkgo.It("should not set any items", func() {
+					ts.StrMap = map[string]string{"a": "1"}
+					valid, err := SetConfigField(tv, *nodeMap["StrMap"].Field(), "")
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					gomega.Expect(valid).To(gomega.BeTrue())
+					gomega.Expect(ts.StrMap).To(gomega.BeEmpty())
+				})
+			})
 		})
 		ginkgo.When("setting a struct pointer slice value", func() {
 			ginkgo.When("the value is valid", func() {
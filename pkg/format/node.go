@@ -249,6 +249,10 @@ func getValueNodeValue(fieldValue reflect.Value, fieldInfo *FieldInfo) (string,
 		base = BaseDecimalLen
 	}
 
+	if vt, isValueType := getValueType(fieldInfo.Type); isValueType {
+		return vt.format(fieldValue), vt.token
+	}
+
 	if fieldInfo.IsEnum() {
 		return fieldInfo.EnumFormatter.Print(int(fieldValue.Int())), EnumToken
 	}
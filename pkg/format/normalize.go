@@ -0,0 +1,43 @@
+package format
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// ParseConfig returns a new config of the same type as the provided config, with the default values set and
+// then updated from the service URL. An error is returned if the resulting config fails validation.
+func ParseConfig(config types.ServiceConfig, serviceURL *url.URL) (types.ServiceConfig, error) {
+	configType := reflect.Indirect(reflect.ValueOf(config)).Type()
+	newConfig := reflect.New(configType).Interface().(types.ServiceConfig)
+
+	pkr := NewPropKeyResolver(newConfig)
+	if err := pkr.SetDefaultProps(newConfig); err != nil {
+		return nil, fmt.Errorf("failed to set default props: %w", err)
+	}
+
+	if err := newConfig.SetURL(serviceURL); err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	if err := ValidateConfig(newConfig); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return newConfig, nil
+}
+
+// Normalize returns the canonical form of the service URL, by parsing it using a new config of the same type
+// as the provided config and returning the resulting URL. In the canonical form, the query props are sorted,
+// use their primary keys and are omitted when they have their default values.
+func Normalize(config types.ServiceConfig, serviceURL *url.URL) (*url.URL, error) {
+	parsedConfig, err := ParseConfig(config, serviceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return getValidatedURL(parsedConfig)
+}
@@ -0,0 +1,132 @@
+package format
+
+import (
+	"net/url"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+type normalizeConfig struct {
+	Host  string   `url:"host"`
+	Level int      `default:"1" key:"level,lvl"`
+	Color uint     `base:"16"   default:"0xFF"  key:"color"`
+	Name  string   `key:"name"  optional:""`
+	Tags  []string `key:"tags"  optional:""`
+
+	secure bool
+	path   string
+}
+
+func (c *normalizeConfig) GetURL() *url.URL {
+	pkr := NewPropKeyResolver(c)
+
+	scheme := "mock"
+	if c.secure {
+		scheme = "mocks"
+	}
+
+	return &url.URL{
+		Scheme:   scheme,
+		Host:     c.Host,
+		Path:     c.path,
+		RawQuery: BuildQuery(&pkr),
+	}
+}
+
+func (c *normalizeConfig) SetURL(serviceURL *url.URL) error {
+	pkr := NewPropKeyResolver(c)
+	c.Host = serviceURL.Host
+
+	for key, vals := range serviceURL.Query() {
+		if err := pkr.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *normalizeConfig) Enums() map[string]types.EnumFormatter {
+	return nil
+}
+
+var _ = ginkgo.Describe("URL normalization", func() {
+	normalize := func(rawURL string) string {
+		normalized, err := Normalize(&normalizeConfig{}, parseURL(rawURL))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		return normalized.String()
+	}
+
+	ginkgo.It("should sort the query and omit default values", func() {
+		gomega.Expect(normalize("mock://example.com?name=test&level=1&color=0xff")).To(gomega.Equal("mock://example.com?name=test"))
+	})
+	ginkgo.It("should use the primary keys instead of aliases", func() {
+		gomega.Expect(normalize("mock://example.com?lvl=3")).To(gomega.Equal("mock://example.com?level=3"))
+	})
+	ginkgo.It("should return the same URL when normalizing a normalized URL", func() {
+		normalized := normalize("mock://example.com?tags=b,a&lvl=2&COLOR=0x0a")
+		gomega.Expect(normalized).To(gomega.Equal("mock://example.com?color=0xa&level=2&tags=b%2Ca"))
+		gomega.Expect(normalize(normalized)).To(gomega.Equal(normalized))
+	})
+	ginkgo.It("should return an error for invalid URLs", func() {
+		_, err := Normalize(&normalizeConfig{}, parseURL("mock://example.com?level=high"))
+		gomega.Expect(err).To(gomega.HaveOccurred())
+	})
+
+	ginkgo.When("parsing a config", func() {
+		ginkgo.It("should set the fields to the same defaults as the services do", func() {
+			config, err := ParseConfig(&normalizeConfig{}, parseURL("mock://example.com"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(config.(*normalizeConfig).Level).To(gomega.Equal(1))
+			gomega.Expect(config.(*normalizeConfig).Tags).To(gomega.Equal([]string{""}))
+		})
+	})
+
+	ginkgo.When("comparing configs", func() {
+		ginkgo.It("should not return any differences for equivalent configs", func() {
+			left := &normalizeConfig{Host: "example.com", Level: 2, Color: 0xFF}
+			right := &normalizeConfig{Host: "example.com", Level: 2, Color: 0xFF}
+			gomega.Expect(DiffConfigs(left, right)).To(gomega.BeEmpty())
+		})
+		ginkgo.It("should return the serialized values of the fields that differ", func() {
+			left := &normalizeConfig{Host: "example.com", Level: 2, Tags: []string{"a"}}
+			right := &normalizeConfig{Host: "example.com", Level: 3, Tags: []string{"a", "b"}}
+
+			diffs := DiffConfigs(left, right)
+			gomega.Expect(diffs).To(gomega.HaveLen(2))
+			gomega.Expect(diffs[0].Field.Name).To(gomega.Equal("Level"))
+			gomega.Expect(diffs[0].Left).To(gomega.Equal("2"))
+			gomega.Expect(diffs[0].Right).To(gomega.Equal("3"))
+			gomega.Expect(diffs[1].Field.Name).To(gomega.Equal("Tags"))
+			gomega.Expect(diffs[1].Right).To(gomega.Equal("a,b"))
+		})
+		ginkgo.It("should return a difference if only the schemes differ", func() {
+			left := &normalizeConfig{Host: "example.com", Level: 2}
+			right := &normalizeConfig{Host: "example.com", Level: 2, secure: true}
+
+			diffs := DiffConfigs(left, right)
+			gomega.Expect(diffs).To(gomega.Equal([]FieldDiff{{Field: SchemeDiffField, Left: "mock", Right: "mocks"}}))
+		})
+		ginkgo.It("should return a difference if only the URLs differ", func() {
+			left := &normalizeConfig{Host: "example.com", Level: 2, path: "/a"}
+			right := &normalizeConfig{Host: "example.com", Level: 2, path: "/b"}
+
+			diffs := DiffConfigs(left, right)
+			gomega.Expect(diffs).To(gomega.HaveLen(1))
+			gomega.Expect(diffs[0].Field).To(gomega.BeIdenticalTo(URLDiffField))
+			gomega.Expect(diffs[0].Left).To(gomega.HavePrefix("mock://example.com/a?"))
+			gomega.Expect(diffs[0].Right).To(gomega.HavePrefix("mock://example.com/b?"))
+		})
+	})
+})
+
+func parseURL(rawURL string) *url.URL {
+	parsed, err := url.Parse(rawURL)
+	gomega.ExpectWithOffset(1, err).NotTo(gomega.HaveOccurred())
+
+	return parsed
+}
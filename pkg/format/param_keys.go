@@ -0,0 +1,58 @@
+package format
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// commonParamKeys are accepted by all services, regardless of whether they are config props or not.
+var commonParamKeys = []string{types.TitleKey, types.MessageKey}
+
+// GetParamKeys returns the keys of the params used by the service, sorted alphabetically, and whether it also
+// accepts params with any other key.
+func GetParamKeys(service types.Service) (keys []string, anyKey bool) {
+	keys = slices.Clone(GetConfigQueryResolver(GetServiceConfig(service)).QueryFields())
+
+	if extraService, ok := service.(types.ExtraParamsService); ok {
+		for _, key := range extraService.ExtraParamKeys() {
+			if key == types.AnyParamKey {
+				anyKey = true
+			} else {
+				keys = append(keys, strings.ToLower(key))
+			}
+		}
+	}
+
+	for _, key := range commonParamKeys {
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return slices.Compact(keys), anyKey
+}
+
+// GetUnknownParamKeys returns the keys of the params that are not used by the service, sorted alphabetically.
+func GetUnknownParamKeys(service types.Service, params types.Params) []string {
+	validKeys, anyKey := GetParamKeys(service)
+	unknownKeys := []string{}
+
+	if anyKey {
+		return unknownKeys
+	}
+
+	for key := range params {
+		if !slices.Contains(validKeys, strings.ToLower(key)) {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+
+	sort.Strings(unknownKeys)
+
+	return unknownKeys
+}
@@ -0,0 +1,47 @@
+package format
+
+import (
+	"net/url"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// paramKeysService is a service using the extra param keys, in addition to the props of its config.
+type paramKeysService struct {
+	standard.Standard
+	Config    *normalizeConfig
+	extraKeys []string
+}
+
+func (*paramKeysService) Send(string, *types.Params) error           { return nil }
+func (*paramKeysService) Initialize(*url.URL, types.StdLogger) error { return nil }
+func (*paramKeysService) GetID() string                              { return "mock" }
+func (service *paramKeysService) ExtraParamKeys() []string           { return service.extraKeys }
+
+var _ = ginkgo.Describe("Param keys", func() {
+	ginkgo.It("should include the config props, the extra keys, the title and the message", func() {
+		keys, anyKey := GetParamKeys(&paramKeysService{extraKeys: []string{"Channel"}})
+		gomega.Expect(keys).To(gomega.Equal([]string{"channel", "color", "level", "lvl", "message", "name", "tags", "title"}))
+		gomega.Expect(anyKey).To(gomega.BeFalse())
+	})
+	ginkgo.It("should return the keys that are not used by the service", func() {
+		service := &paramKeysService{extraKeys: []string{"channel"}}
+		gomega.Expect(GetUnknownParamKeys(service, types.Params{
+			"Channel": "a",
+			"title":   "b",
+			"LEVEL":   "1",
+			"foo":     "c",
+			"bar":     "d",
+		})).To(gomega.Equal([]string{"bar", "foo"}))
+	})
+	ginkgo.It("should not return any keys if the service accepts any key", func() {
+		service := &paramKeysService{extraKeys: []string{types.AnyParamKey}}
+		_, anyKey := GetParamKeys(service)
+		gomega.Expect(anyKey).To(gomega.BeTrue())
+		gomega.Expect(GetUnknownParamKeys(service, types.Params{"foo": "c"})).To(gomega.BeEmpty())
+	})
+})
@@ -72,6 +72,13 @@ func (pkr *PropKeyResolver) Set(key string, value string) error {
 // set sets the value of a target struct tagged with the corresponding key.
 func (pkr *PropKeyResolver) set(target reflect.Value, key string, value string) error {
 	if field, found := pkr.keyFields[strings.ToLower(key)]; found {
+		// Missing required values are reported by ValidateConfig, since props can be unset
+		if value != "" {
+			if err := ValidateFieldValue(&field, value); err != nil {
+				return err
+			}
+		}
+
 		valid, err := SetConfigField(target, field, value)
 		if !valid && err == nil {
 			return errors.New("invalid value for type")
@@ -85,19 +92,34 @@ func (pkr *PropKeyResolver) set(target reflect.Value, key string, value string)
 
 // UpdateConfigFromParams mutates the provided config, updating the values from it's corresponding params
 // If the provided config is nil, the internal config will be updated instead.
-// The error returned is the first error that occurred, subsequent errors are just discarded.
+// The error returned is the first error that occurred, subsequent errors are just discarded. If all params
+// were set, the updated config is validated, since params can also unset required values.
 func (pkr *PropKeyResolver) UpdateConfigFromParams(config types.ServiceConfig, params *types.Params) (firstError error) {
 	confValue := pkr.configValueOrInternal(config)
 
-	if params != nil {
-		for key, val := range *params {
-			if err := pkr.set(confValue, key, val); err != nil && firstError == nil {
-				firstError = err
-			}
+	if params == nil || len(*params) < 1 {
+		return nil
+	}
+
+	for key, val := range *params {
+		if err := pkr.set(confValue, key, val); err != nil && firstError == nil {
+			firstError = err
 		}
 	}
 
-	return
+	if firstError != nil {
+		return firstError
+	}
+
+	if config == nil && confValue.CanAddr() {
+		config, _ = confValue.Addr().Interface().(types.ServiceConfig)
+	}
+
+	if config == nil {
+		return nil
+	}
+
+	return ValidateConfig(config)
 }
 
 // SetDefaultProps mutates the provided config, setting the tagged fields with their default values
@@ -154,6 +176,24 @@ func configValue(config types.ServiceConfig) reflect.Value {
 }
 
 // IsDefault returns whether the specified key value is the default value.
+// Default values that are not in their canonical form (like "0x50D9ff" for "0x50d9ff") are also matched.
 func (pkr *PropKeyResolver) IsDefault(key string, value string) bool {
-	return pkr.keyFields[key].DefaultValue == value
+	field := pkr.keyFields[key]
+	if field.DefaultValue == value {
+		return true
+	}
+
+	if field.DefaultValue == "" || !pkr.confValue.IsValid() {
+		return false
+	}
+
+	// Format the default value the same way as the field value by setting it on a temporary config
+	target := reflect.New(pkr.confValue.Type()).Elem()
+	if valid, err := SetConfigField(target, field, field.DefaultValue); !valid || err != nil {
+		return false
+	}
+
+	defaultValue, err := GetConfigFieldString(target, field)
+
+	return err == nil && defaultValue == value
 }
@@ -0,0 +1,324 @@
+package format
+
+import (
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Redacted is used in place of secret values.
+const Redacted = "REDACTED"
+
+// minRedactedTextLength is the minimum length of secret values that are redacted from free text, since
+// replacing shorter values would mangle the text without hiding anything of value.
+const minRedactedTextLength = 4
+
+// minRedactedPartLength is the minimum length of the parts of secret values that are also redacted on their own,
+// since secrets like tokens are often used in other forms, e.g. with slashes instead of dashes in a webhook URL.
+const minRedactedPartLength = 8
+
+// secretSeparators are the characters that secret values are split on to get their parts.
+const secretSeparators = "-/:,"
+
+// urlCredentialsPattern matches the scheme and user info of URLs in free text.
+var urlCredentialsPattern = regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*://)[^/?#@\s]+@`)
+
+// RedactURL returns the URL of the service config, with the values of all secret fields masked.
+func RedactURL(service types.Service) string {
+	return RedactConfigURL(GetServiceConfig(service))
+}
+
+// RedactConfigURL returns the URL of the config, with the values of all secret fields masked.
+// Any password in the user info of the URL is masked as well, even if it's not tagged as secret.
+// Configs that do not have any secret fields (like the generic webhook config, which keeps the webhook URL in
+// unexported state) are masked using RedactUnknownURL, since their secrets are not known.
+func RedactConfigURL(config types.ServiceConfig) string {
+	configURL := config.GetURL()
+	if configURL == nil {
+		return ""
+	}
+
+	secretFields := getSecretFields(config)
+	if len(secretFields) < 1 {
+		return RedactUnknownURL(configURL)
+	}
+
+	redacted := *configURL
+	configValue := reflect.Indirect(reflect.ValueOf(config))
+
+	for _, field := range secretFields {
+		value, _ := GetConfigFieldString(configValue, *field)
+		redactURLField(&redacted, field, value)
+	}
+
+	if _, hasPassword := redacted.User.Password(); hasPassword {
+		redacted.User = url.UserPassword(redacted.User.Username(), Redacted)
+	}
+
+	return redacted.String()
+}
+
+// RedactUnknownURL returns the URL with the user info, path and query values masked. It is used for URLs that
+// cannot be parsed by a service config, and where the location of the secret values is therefore unknown.
+func RedactUnknownURL(rawURL *url.URL) string {
+	redacted := *rawURL
+
+	if redacted.User != nil {
+		redacted.User = url.User(Redacted)
+	}
+
+	if strings.Trim(redacted.Path, "/") != "" {
+		redacted.Path = "/" + Redacted
+		redacted.RawPath = ""
+	}
+
+	if strings.Trim(redacted.Opaque, "/") != "" {
+		redacted.Opaque = ""
+	}
+	query := redacted.Query()
+
+	for key := range query {
+		query.Set(key, Redacted)
+	}
+
+	redacted.RawQuery = query.Encode()
+
+	return redacted.String()
+}
+
+// RedactSecrets returns the text with all occurrences of the config secret values (in their plain, path
+// escaped and query escaped forms, as well as their long enough parts) replaced. For configs that do not
+// have any secret fields, the user info, path segments and query values of the config URL that are long
+// enough to be secret are replaced instead.
+func RedactSecrets(config types.ServiceConfig, text string) string {
+	secretFields := getSecretFields(config)
+	if len(secretFields) < 1 {
+		return redactValues(text, getUnknownSecretValues(config.GetURL()))
+	}
+
+	configValue := reflect.Indirect(reflect.ValueOf(config))
+	values := []string{}
+
+	for _, field := range secretFields {
+		value, _ := GetConfigFieldString(configValue, *field)
+		values = append(values, value)
+	}
+
+	return redactValues(text, values)
+}
+
+// RedactURLValues returns the text with all occurrences of the user info, path segments and query values of
+// the URL replaced. It is used for text related to URLs that could not be parsed by a service config, and
+// where the location of the secret values is therefore unknown.
+func RedactURLValues(rawURL *url.URL, text string) string {
+	return redactValues(text, getURLValues(rawURL))
+}
+
+// RedactURLCredentials returns the text with the user info of all URLs in it replaced.
+func RedactURLCredentials(text string) string {
+	return urlCredentialsPattern.ReplaceAllString(text, "${1}"+Redacted+"@")
+}
+
+// RedactError returns an error with the config secret values removed from its message. The returned error
+// wraps the original error, so that it can still be matched using errors.Is and errors.As.
+func RedactError(config types.ServiceConfig, err error) error {
+	return redactError(err, func(message string) string {
+		return RedactSecrets(config, message)
+	})
+}
+
+// RedactURLError returns an error with the URL values removed from its message, as done by RedactURLValues.
+// The returned error wraps the original error.
+func RedactURLError(rawURL *url.URL, err error) error {
+	return redactError(err, func(message string) string {
+		return RedactURLValues(rawURL, message)
+	})
+}
+
+func redactError(err error, redact func(message string) string) error {
+	if err == nil {
+		return nil
+	}
+
+	message := err.Error()
+
+	redacted := redact(message)
+	if redacted == message {
+		return err
+	}
+
+	return &redactedError{message: redacted, err: err}
+}
+
+// getURLValues returns the user info, path segments and query values of the URL.
+func getURLValues(rawURL *url.URL) []string {
+	values := strings.Split(rawURL.Path, "/")
+
+	if rawURL.User != nil {
+		values = append(values, rawURL.User.Username())
+
+		if password, hasPassword := rawURL.User.Password(); hasPassword {
+			values = append(values, password)
+		}
+	}
+
+	for _, queryValues := range rawURL.Query() {
+		values = append(values, queryValues...)
+	}
+
+	return values
+}
+
+// getUnknownSecretValues returns the URL values that are long enough to be secret, for configs where the
+// location of the secret values is not known. Shorter values are skipped, since they are most likely common
+// words (like "api" or "json") that would mangle the text.
+func getUnknownSecretValues(configURL *url.URL) []string {
+	if configURL == nil {
+		return nil
+	}
+
+	values := []string{}
+
+	for _, value := range getURLValues(configURL) {
+		if len(value) >= minRedactedPartLength {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// redactValues replaces the values (in their plain, path escaped and query escaped forms) in the text, as well
+// as the parts of the values that are long enough to be secret on their own.
+func redactValues(text string, values []string) string {
+	forms := []string{}
+
+	for _, value := range values {
+		if len(value) < minRedactedTextLength {
+			continue
+		}
+
+		forms = append(forms, value, url.PathEscape(value), url.QueryEscape(value))
+
+		parts := strings.FieldsFunc(value, func(r rune) bool { return strings.ContainsRune(secretSeparators, r) })
+		for _, part := range parts {
+			if len(part) >= minRedactedPartLength {
+				forms = append(forms, part)
+			}
+		}
+	}
+
+	// Longer forms are replaced first, so that the values are not partially replaced by their parts
+	sort.SliceStable(forms, func(i, j int) bool { return len(forms[i]) > len(forms[j]) })
+
+	for _, form := range forms {
+		text = strings.ReplaceAll(text, form, Redacted)
+	}
+
+	return text
+}
+
+type redactedError struct {
+	message string
+	err     error
+}
+
+func (re *redactedError) Error() string {
+	return re.message
+}
+
+func (re *redactedError) Unwrap() error {
+	return re.err
+}
+
+func getSecretFields(config types.ServiceConfig) []*FieldInfo {
+	fields := []*FieldInfo{}
+
+	for _, node := range GetConfigFormat(config).Items {
+		if field := node.Field(); field.Secret {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// redactURLField masks the URL parts and query keys of the field, as well as any path segments and
+// query values that are equal to the field value (for configs that serialize the field manually).
+func redactURLField(serviceURL *url.URL, field *FieldInfo, value string) {
+	for _, part := range field.URLParts {
+		switch part {
+		case URLUser:
+			if serviceURL.User != nil {
+				password, hasPassword := serviceURL.User.Password()
+				if hasPassword {
+					serviceURL.User = url.UserPassword(Redacted, password)
+				} else {
+					serviceURL.User = url.User(Redacted)
+				}
+			}
+		case URLPassword:
+			if serviceURL.User != nil {
+				serviceURL.User = url.UserPassword(serviceURL.User.Username(), Redacted)
+			}
+		case URLHost:
+			if port := serviceURL.Port(); port != "" {
+				serviceURL.Host = Redacted + ":" + port
+			} else {
+				serviceURL.Host = Redacted
+			}
+		case URLQuery, URLPort:
+		default:
+			// Path parts are handled by matching the value below
+		}
+	}
+
+	query := serviceURL.Query()
+
+	for key, values := range query {
+		lowerKey := strings.ToLower(key)
+		for i, queryValue := range values {
+			if (value != "" && queryValue == value) || slices.Contains(field.Keys, lowerKey) {
+				values[i] = Redacted
+			}
+		}
+	}
+
+	serviceURL.RawQuery = query.Encode()
+
+	if value == "" {
+		return
+	}
+
+	segments := strings.Split(serviceURL.Path, "/")
+	for i, segment := range segments {
+		if segment == value {
+			segments[i] = Redacted
+		}
+	}
+
+	serviceURL.Path = strings.Join(segments, "/")
+
+	if strings.Contains(value, "/") {
+		serviceURL.Path = strings.ReplaceAll(serviceURL.Path, value, Redacted)
+	}
+
+	serviceURL.RawPath = ""
+}
+
+// isEmptyNode returns whether the node is a value node without a value, or a container node without items.
+func isEmptyNode(node Node) bool {
+	switch node := node.(type) {
+	case *ValueNode:
+		return node.Value == ""
+	case *ContainerNode:
+		return len(node.Items) < 1
+	}
+
+	return true
+}
@@ -43,7 +43,14 @@ func (r ConsoleTreeRenderer) RenderTree(root *ContainerNode, _ string) string {
 
 		if r.WithValues {
 			preLen = ValueOffset // Adjusts the spacing when values are included
-			valueLen = r.writeNodeValue(&sb, node)
+
+			if field.Secret && !isEmptyNode(node) {
+				sb.WriteString(ColorizeString(Redacted))
+
+				valueLen = len(Redacted)
+			} else {
+				valueLen = r.writeNodeValue(&sb, node)
+			}
 		} else {
 			// Since no values was supplied, let's substitute the value with the type
 			typeName := field.Type.String()
@@ -88,10 +95,22 @@ func (r ConsoleTreeRenderer) RenderTree(root *ContainerNode, _ string) string {
 			sb.WriteString(fmt.Sprintf(" <Default: %s>", ColorizeValue(field.DefaultValue, field.EnumFormatter != nil)))
 		}
 
+		if r.WithValues && field.Source != SourceUnknown {
+			sb.WriteString(fmt.Sprintf(" <Source: %s>", ColorizeEnum(field.Source)))
+		}
+
 		if field.Required {
 			sb.WriteString(fmt.Sprintf(" <%s>", ColorizeFalse("Required")))
 		}
 
+		if field.Secret {
+			sb.WriteString(fmt.Sprintf(" <%s>", ColorizeFalse("Secret")))
+		}
+
+		if rules := field.Validation.Rules(); len(rules) > 0 {
+			sb.WriteString(fmt.Sprintf(" <Validation: %s>", ColorizeString(strings.Join(rules, "; "))))
+		}
+
 		if len(field.Keys) > 1 {
 			sb.WriteString(" <Aliases: ")
 
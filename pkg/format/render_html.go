@@ -0,0 +1,204 @@
+package format
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// DefaultHTMLHeadingLevel is the heading level used by HTMLTreeRenderer if none is specified.
+const DefaultHTMLHeadingLevel = 3
+
+// HTMLTreeRenderer renders a ContainerNode tree into an HTML documentation fragment, which can be embedded
+// in another page. Every field has an anchor with the ID "<scheme>-<field name>" (lower case, prefixed by
+// IDPrefix), and the possible values of enum fields are listed in a table.
+type HTMLTreeRenderer struct {
+	HeadingLevel      int
+	IDPrefix          string
+	PropsDescription  string
+	PropsEmptyMessage string
+}
+
+// RenderTree renders a ContainerNode tree into an HTML documentation fragment.
+func (r HTMLTreeRenderer) RenderTree(root *ContainerNode, scheme string) string {
+	sb := strings.Builder{}
+
+	urlFields, queryFields := getDocFields(root)
+
+	fmt.Fprintf(&sb, "<section class=\"service-docs\" id=\"%s\">\n", r.getID(scheme, ""))
+
+	r.writeHeading(&sb, "URL Fields")
+	sb.WriteString("<dl class=\"url-fields\">\n")
+
+	fieldsPrinted := make(map[string]bool)
+
+	for _, field := range urlFields {
+		if field == nil || fieldsPrinted[field.Name] {
+			continue
+		}
+
+		r.writeFieldTerm(&sb, field, scheme)
+		sb.WriteString("<dd>\n")
+		writeHTMLDescription(&sb, field)
+		sb.WriteString("<p>URL part: <code class=\"service-url\">")
+		writeURLTemplate(&sb, urlFields, scheme, field, "<strong>", "</strong>")
+		sb.WriteString("</code></p>\n")
+		writeHTMLFieldExtras(&sb, field)
+		sb.WriteString("</dd>\n")
+
+		fieldsPrinted[field.Name] = true
+	}
+
+	sb.WriteString("</dl>\n")
+
+	r.writeHeading(&sb, "Query/Param Props")
+
+	if len(queryFields) > 0 {
+		writeHTMLParagraph(&sb, r.PropsDescription)
+		sb.WriteString("<dl class=\"query-props\">\n")
+
+		for _, field := range queryFields {
+			r.writeFieldTerm(&sb, field, scheme)
+			sb.WriteString("<dd>\n")
+			writeHTMLDescription(&sb, field)
+			writeHTMLDefault(&sb, field)
+			writeHTMLFieldExtras(&sb, field)
+			sb.WriteString("</dd>\n")
+		}
+
+		sb.WriteString("</dl>\n")
+	} else {
+		writeHTMLParagraph(&sb, r.PropsEmptyMessage)
+	}
+
+	r.writeHeading(&sb, "Example")
+	sb.WriteString("<pre><code class=\"service-url\">")
+	sb.WriteString(html.EscapeString(getExampleURL(urlFields, queryFields, scheme)))
+	sb.WriteString("</code></pre>\n")
+
+	sb.WriteString("</section>\n")
+
+	return sb.String()
+}
+
+func (r HTMLTreeRenderer) getID(scheme string, fieldName string) string {
+	id := r.IDPrefix + scheme
+	if fieldName != "" {
+		id += "-" + strings.ToLower(fieldName)
+	}
+
+	return html.EscapeString(id)
+}
+
+func (r HTMLTreeRenderer) writeHeading(sb *strings.Builder, text string) {
+	level := r.HeadingLevel
+	if level < 1 {
+		level = DefaultHTMLHeadingLevel
+	}
+
+	fmt.Fprintf(sb, "<h%d>%s</h%d>\n", level, html.EscapeString(text), level)
+}
+
+func (r HTMLTreeRenderer) writeFieldTerm(sb *strings.Builder, field *FieldInfo, scheme string) {
+	id := r.getID(scheme, field.Name)
+
+	fmt.Fprintf(sb, "<dt id=\"%s\"><a href=\"#%s\">%s</a>", id, id, html.EscapeString(field.Name))
+
+	if field.Required {
+		sb.WriteString(" <span class=\"required\">Required</span>")
+	}
+
+	if field.Secret {
+		sb.WriteString(" <span class=\"secret\">Secret</span>")
+	}
+
+	sb.WriteString("</dt>\n")
+}
+
+func writeHTMLParagraph(sb *strings.Builder, text string) {
+	if text == "" {
+		return
+	}
+
+	sb.WriteString("<p>")
+	sb.WriteString(html.EscapeString(strings.TrimSpace(text)))
+	sb.WriteString("</p>\n")
+}
+
+func writeHTMLDescription(sb *strings.Builder, field *FieldInfo) {
+	if field.Description == "" {
+		return
+	}
+
+	sb.WriteString("<p class=\"description\">")
+	sb.WriteString(html.EscapeString(field.Description))
+	sb.WriteString("</p>\n")
+}
+
+func writeHTMLDefault(sb *strings.Builder, field *FieldInfo) {
+	if field.Required {
+		return
+	}
+
+	sb.WriteString("<p>Default: ")
+
+	if field.DefaultValue == "" {
+		sb.WriteString("<em>empty</em>")
+	} else {
+		sb.WriteString("<code>")
+		sb.WriteString(html.EscapeString(field.DefaultValue))
+		sb.WriteString("</code>")
+	}
+
+	sb.WriteString("</p>\n")
+}
+
+func writeHTMLFieldExtras(sb *strings.Builder, field *FieldInfo) {
+	if len(field.Keys) > 1 {
+		writeHTMLCodeList(sb, "Aliases", field.Keys[1:])
+	}
+
+	if formatName := getValueFormatName(field); formatName != "" {
+		sb.WriteString("<p>Format: ")
+		sb.WriteString(html.EscapeString(formatName))
+		sb.WriteString("</p>\n")
+	}
+
+	if rules := field.Validation.Rules(); len(rules) > 0 {
+		writeHTMLCodeList(sb, "Validation", rules)
+	}
+
+	if field.EnumFormatter == nil {
+		return
+	}
+
+	sb.WriteString("<table class=\"enum-values\">\n")
+	sb.WriteString("<thead><tr><th>Value</th><th>Number</th></tr></thead>\n")
+	sb.WriteString("<tbody>\n")
+
+	for _, name := range field.EnumFormatter.Names() {
+		fmt.Fprintf(sb, "<tr><td><code>%s</code></td><td>%d</td></tr>\n",
+			html.EscapeString(name), field.EnumFormatter.Parse(name))
+	}
+
+	sb.WriteString("</tbody>\n")
+	sb.WriteString("</table>\n")
+}
+
+func writeHTMLCodeList(sb *strings.Builder, label string, items []string) {
+	sb.WriteString("<p>")
+	sb.WriteString(label)
+	sb.WriteString(": ")
+
+	for i, item := range items {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString("<code>")
+		sb.WriteString(html.EscapeString(item))
+		sb.WriteString("</code>")
+	}
+
+	sb.WriteString("</p>\n")
+}
@@ -0,0 +1,73 @@
+package format
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("RenderHTML", func() {
+	ginkgo.It("should render the expected output based on config reflection/tags", func() {
+		actual := testRenderTree(HTMLTreeRenderer{IDPrefix: "svc-"}, &struct {
+			Name  string `default:"<none>" desc:"The name" key:"name,alias"`
+			Host  string `url:"host"`
+			Token string `secret:""        url:"path"`
+			Count int    `key:"count"`
+		}{})
+
+		expected := `
+<section class="service-docs" id="svc-mock">
+<h3>URL Fields</h3>
+<dl class="url-fields">
+<dt id="svc-mock-host"><a href="#svc-mock-host">Host</a> <span class="required">Required</span></dt>
+<dd>
+<p>URL part: <code class="service-url">mock://<strong>host</strong>/token</code></p>
+</dd>
+<dt id="svc-mock-token"><a href="#svc-mock-token">Token</a> <span class="required">Required</span> <span class="secret">Secret</span></dt>
+<dd>
+<p>URL part: <code class="service-url">mock://host/<strong>token</strong></code></p>
+</dd>
+</dl>
+<h3>Query/Param Props</h3>
+<dl class="query-props">
+<dt id="svc-mock-count"><a href="#svc-mock-count">Count</a> <span class="required">Required</span></dt>
+<dd>
+</dd>
+<dt id="svc-mock-name"><a href="#svc-mock-name">Name</a></dt>
+<dd>
+<p class="description">The name</p>
+<p>Default: <code>&lt;none&gt;</code></p>
+<p>Aliases: <code>alias</code></p>
+</dd>
+</dl>
+<h3>Example</h3>
+<pre><code class="service-url">mock://host/token?count=count</code></pre>
+</section>
+`[1:]
+
+		gomega.Expect(actual).To(gomega.Equal(expected))
+	})
+
+	ginkgo.It("should render the possible enum values as a table", func() {
+		actual := testRenderTree(HTMLTreeRenderer{HeadingLevel: 2}, &testEnummer{})
+
+		gomega.Expect(actual).To(gomega.ContainSubstring("<h2>Query/Param Props</h2>\n"))
+		gomega.Expect(actual).To(gomega.ContainSubstring(`
+<table class="enum-values">
+<thead><tr><th>Value</th><th>Number</th></tr></thead>
+<tbody>
+<tr><td><code>Yes</code></td><td>0</td></tr>
+<tr><td><code>No</code></td><td>1</td></tr>
+<tr><td><code>Maybe</code></td><td>2</td></tr>
+</tbody>
+</table>
+`))
+	})
+
+	ginkgo.It("should render the empty message for configs without props", func() {
+		actual := testRenderTree(HTMLTreeRenderer{PropsEmptyMessage: "No props"}, &struct {
+			Host string `url:"host"`
+		}{})
+
+		gomega.Expect(actual).To(gomega.ContainSubstring("<h3>Query/Param Props</h3>\n<p>No props</p>\n"))
+	})
+})
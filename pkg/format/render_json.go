@@ -0,0 +1,138 @@
+package format
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// JSONTreeRenderer renders a ContainerNode tree into a JSON string.
+type JSONTreeRenderer struct {
+	WithValues bool
+}
+
+// JSONServiceConfig is the JSON representation of a service config node tree.
+type JSONServiceConfig struct {
+	Service string      `json:"service"`
+	Fields  []JSONField `json:"fields"`
+}
+
+// JSONField is the JSON representation of a single config field node.
+type JSONField struct {
+	Name        string               `json:"name"`
+	Type        string               `json:"type"`
+	Description string               `json:"description,omitempty"`
+	Default     string               `json:"default,omitempty"`
+	Required    bool                 `json:"required"`
+	Secret      bool                 `json:"secret,omitempty"`
+	Template    string               `json:"template,omitempty"`
+	URLParts    []string             `json:"urlParts,omitempty"`
+	Keys        []string             `json:"keys,omitempty"`
+	Enum        []string             `json:"enum,omitempty"`
+	Validation  *JSONFieldValidation `json:"validation,omitempty"`
+	Value       any                  `json:"value,omitempty"`
+	Source      string               `json:"source,omitempty"`
+}
+
+// JSONFieldValidation is the JSON representation of the validation rules of a config field.
+type JSONFieldValidation struct {
+	Pattern string   `json:"pattern,omitempty"`
+	Min     *float64 `json:"min,omitempty"`
+	Max     *float64 `json:"max,omitempty"`
+	OneOf   []string `json:"oneOf,omitempty"`
+	Format  string   `json:"format,omitempty"`
+}
+
+// RenderTree renders a ContainerNode tree into an indented JSON string.
+func (r JSONTreeRenderer) RenderTree(root *ContainerNode, scheme string) string {
+	bytes, err := json.MarshalIndent(r.ServiceConfig(root, scheme), "", "  ")
+	if err != nil {
+		return ""
+	}
+
+	return string(bytes)
+}
+
+// ServiceConfig returns the JSON serializable representation of a ContainerNode tree.
+func (r JSONTreeRenderer) ServiceConfig(root *ContainerNode, scheme string) JSONServiceConfig {
+	fields := make([]JSONField, 0, len(root.Items))
+
+	for _, node := range root.Items {
+		fields = append(fields, r.getField(node))
+	}
+
+	return JSONServiceConfig{
+		Service: scheme,
+		Fields:  fields,
+	}
+}
+
+func (r JSONTreeRenderer) getField(node Node) JSONField {
+	field := node.Field()
+
+	jsonField := JSONField{
+		Name:        field.Name,
+		Type:        field.Type.String(),
+		Description: field.Description,
+		Default:     field.DefaultValue,
+		Required:    field.Required,
+		Secret:      field.Secret,
+		Template:    field.Template,
+		Keys:        field.Keys,
+	}
+
+	for _, part := range field.URLParts {
+		jsonField.URLParts = append(jsonField.URLParts, part.Tag())
+	}
+
+	if field.EnumFormatter != nil {
+		jsonField.Type = "option"
+		jsonField.Enum = field.EnumFormatter.Names()
+	}
+
+	if rules := field.Validation; len(rules.Rules()) > 0 {
+		jsonField.Validation = &JSONFieldValidation{
+			Pattern: rules.Pattern,
+			Min:     rules.Min,
+			Max:     rules.Max,
+			OneOf:   rules.OneOf,
+			Format:  rules.Format,
+		}
+	}
+
+	if r.WithValues {
+		if field.Secret && !isEmptyNode(node) {
+			jsonField.Value = Redacted
+		} else {
+			jsonField.Value = getJSONNodeValue(node)
+		}
+
+		jsonField.Source = string(field.Source)
+	}
+
+	return jsonField
+}
+
+func getJSONNodeValue(node Node) any {
+	switch node := node.(type) {
+	case *ValueNode:
+		return node.Value
+	case *ContainerNode:
+		if node.Type.Kind() == reflect.Map {
+			values := make(map[string]any, len(node.Items))
+			for _, item := range node.Items {
+				values[item.Field().Name] = getJSONNodeValue(item)
+			}
+
+			return values
+		}
+
+		values := make([]any, 0, len(node.Items))
+		for _, item := range node.Items {
+			values = append(values, getJSONNodeValue(item))
+		}
+
+		return values
+	}
+
+	return nil
+}
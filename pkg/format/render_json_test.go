@@ -0,0 +1,55 @@
+package format
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("RenderJSON", func() {
+	ginkgo.It("should render the expected output based on config reflection/tags", func() {
+		actual := testRenderTree(JSONTreeRenderer{WithValues: false}, &struct {
+			Name string `default:"notempty" desc:"The name" key:"name,alias"`
+			Host string `url:"host,port"`
+		}{})
+
+		gomega.Expect(actual).To(gomega.MatchJSON(`{
+			"service": "mock",
+			"fields": [
+				{"name": "Host", "type": "string", "required": true, "urlParts": ["host", "port"]},
+				{"name": "Name", "type": "string", "description": "The name", "default": "notempty", "required": false, "keys": ["name", "alias"]}
+			]
+		}`))
+	})
+
+	ginkgo.It(`should render enum types as "option" with the possible values`, func() {
+		actual := testRenderTree(JSONTreeRenderer{WithValues: false}, &testEnummer{})
+
+		gomega.Expect(actual).To(gomega.MatchJSON(`{
+			"service": "mock",
+			"fields": [
+				{"name": "Choice", "type": "option", "default": "Maybe", "required": false, "keys": ["choice"], "enum": ["Yes", "No", "Maybe"]}
+			]
+		}`))
+	})
+
+	ginkgo.It("should render the values of fields and containers", func() {
+		actual := testRenderTree(JSONTreeRenderer{WithValues: true}, &struct {
+			Name  string
+			Tags  []string
+			Props map[string]string
+		}{
+			Name:  "foo",
+			Tags:  []string{"a", "b"},
+			Props: map[string]string{"key": "value"},
+		})
+
+		gomega.Expect(actual).To(gomega.MatchJSON(`{
+			"service": "mock",
+			"fields": [
+				{"name": "Name", "type": "string", "required": true, "value": "foo"},
+				{"name": "Props", "type": "map[string]string", "required": true, "value": {"key": "value"}},
+				{"name": "Tags", "type": "[]string", "required": true, "value": ["a", "b"]}
+			]
+		}`))
+	})
+})
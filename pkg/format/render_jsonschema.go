@@ -0,0 +1,258 @@
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// JSONSchemaDraft is the JSON Schema dialect used by JSONSchemaTreeRenderer.
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchemaTreeRenderer renders a ContainerNode tree into a JSON Schema describing the service config.
+// The schema has an "url" object property with the fields that are part of the URL, and a "query"
+// object property with the query/param props, keyed by their primary key.
+type JSONSchemaTreeRenderer struct{}
+
+// JSONSchema is a subset of the JSON Schema vocabulary, extended with "x-" keywords for the URL parts,
+// key aliases, item separator and value formats (that have no JSON Schema equivalent) of the config fields.
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties any                    `json:"additionalProperties,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Minimum              *float64               `json:"minimum,omitempty"`
+	Maximum              *float64               `json:"maximum,omitempty"`
+	MinLength            *int                   `json:"minLength,omitempty"`
+	MaxLength            *int                   `json:"maxLength,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+	Default              any                    `json:"default,omitempty"`
+	WriteOnly            bool                   `json:"writeOnly,omitempty"`
+	ValueFormat          string                 `json:"x-format,omitempty"`
+	URLParts             []string               `json:"x-url-parts,omitempty"`
+	Aliases              []string               `json:"x-aliases,omitempty"`
+	ItemSeparator        string                 `json:"x-separator,omitempty"`
+}
+
+// hexPattern matches the hexadecimal representation of numbers using base 16.
+const hexPattern = "^(0x)?[0-9a-fA-F]+$"
+
+var configPropType = reflect.TypeOf((*types.ConfigProp)(nil)).Elem()
+
+// RenderTree renders a ContainerNode tree into an indented JSON Schema string.
+func (r JSONSchemaTreeRenderer) RenderTree(root *ContainerNode, scheme string) string {
+	bytes, err := json.MarshalIndent(r.Schema(root, scheme), "", "  ")
+	if err != nil {
+		return ""
+	}
+
+	return string(bytes)
+}
+
+// Schema returns the JSON Schema for a ContainerNode tree.
+func (r JSONSchemaTreeRenderer) Schema(root *ContainerNode, scheme string) *JSONSchema {
+	urlSchema := newObjectSchema("The fields that are part of the service URL")
+	querySchema := newObjectSchema("The query/param props")
+	querySchema.AdditionalProperties = false
+
+	for _, node := range root.Items {
+		field := node.Field()
+		fieldSchema := getFieldSchema(field)
+
+		if len(field.URLParts) > 0 && !field.IsURLPart(URLQuery) {
+			for _, part := range field.URLParts {
+				fieldSchema.URLParts = append(fieldSchema.URLParts, part.Tag())
+			}
+
+			addSchemaProperty(urlSchema, field.Name, fieldSchema, field.Required)
+
+			continue
+		}
+
+		key := strings.ToLower(field.Name)
+		if len(field.Keys) > 0 {
+			key = field.Keys[0]
+			fieldSchema.Aliases = field.Keys[1:]
+		}
+
+		addSchemaProperty(querySchema, key, fieldSchema, field.Required)
+	}
+
+	schema := newObjectSchema(fmt.Sprintf("Configuration of the %s service", scheme))
+	schema.Schema = JSONSchemaDraft
+	schema.Title = scheme
+	schema.Properties["url"] = urlSchema
+	schema.Properties["query"] = querySchema
+
+	if len(urlSchema.Required) > 0 {
+		schema.Required = append(schema.Required, "url")
+	}
+
+	if len(querySchema.Required) > 0 {
+		schema.Required = append(schema.Required, "query")
+	}
+
+	return schema
+}
+
+func newObjectSchema(description string) *JSONSchema {
+	return &JSONSchema{
+		Description: description,
+		Type:        "object",
+		Properties:  map[string]*JSONSchema{},
+	}
+}
+
+func addSchemaProperty(schema *JSONSchema, name string, property *JSONSchema, required bool) {
+	schema.Properties[name] = property
+
+	if required {
+		schema.Required = append(schema.Required, name)
+	}
+}
+
+func getFieldSchema(field *FieldInfo) *JSONSchema {
+	schema := getTypeSchema(field.Type, field)
+	schema.Description = field.Description
+	schema.WriteOnly = field.Secret
+
+	if field.DefaultValue != "" {
+		schema.Default = getSchemaDefault(schema, field)
+	}
+
+	applySchemaValidation(schema, field.Validation)
+
+	return schema
+}
+
+// applySchemaValidation adds the keywords corresponding to the validation rules of the field to the schema.
+// For arrays, the item count is constrained by the schema, and the rest of the rules by the items schema.
+func applySchemaValidation(schema *JSONSchema, validation FieldValidation) {
+	itemSchema := schema
+
+	switch schema.Type {
+	case "array":
+		schema.MinItems = floatToIntPtr(validation.Min)
+		schema.MaxItems = floatToIntPtr(validation.Max)
+		itemSchema = schema.Items
+	case "integer", "number":
+		schema.Minimum = validation.Min
+		schema.Maximum = validation.Max
+	default:
+		schema.MinLength = floatToIntPtr(validation.Min)
+		schema.MaxLength = floatToIntPtr(validation.Max)
+	}
+
+	if itemSchema == nil {
+		return
+	}
+
+	if validation.Pattern != "" {
+		itemSchema.Pattern = validation.Pattern
+	}
+
+	if len(validation.OneOf) > 0 {
+		itemSchema.Enum = validation.OneOf
+	}
+
+	switch validation.Format {
+	case FormatEmail:
+		itemSchema.Format = "email"
+	case FormatURL:
+		itemSchema.Format = "uri"
+	case "":
+	default:
+		itemSchema.ValueFormat = validation.Format
+	}
+}
+
+func floatToIntPtr(value *float64) *int {
+	if value == nil {
+		return nil
+	}
+
+	intValue := int(*value)
+
+	return &intValue
+}
+
+func getTypeSchema(fieldType reflect.Type, field *FieldInfo) *JSONSchema {
+	if vt, isValueType := getValueType(fieldType); isValueType {
+		return &JSONSchema{Type: "string", Format: vt.schemaFormat, ValueFormat: vt.valueFormat}
+	}
+
+	if field.EnumFormatter != nil && fieldType.Kind() == reflect.Int {
+		return &JSONSchema{Type: "string", Enum: field.EnumFormatter.Names()}
+	}
+
+	if reflect.PointerTo(fieldType).Implements(configPropType) {
+		return &JSONSchema{Type: "string"}
+	}
+
+	switch kind := fieldType.Kind(); {
+	case kind == reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case kind == reflect.Float32 || kind == reflect.Float64:
+		return &JSONSchema{Type: "number"}
+	case kind >= reflect.Int && kind <= reflect.Uint64:
+		if field.Base != 0 && field.Base != DefaultBase {
+			return &JSONSchema{Type: "string", Pattern: hexPattern}
+		}
+
+		return &JSONSchema{Type: "integer"}
+	case kind == reflect.Slice || kind == reflect.Array:
+		schema := &JSONSchema{
+			Type:  "array",
+			Items: getTypeSchema(fieldType.Elem(), &FieldInfo{Base: field.Base}),
+		}
+
+		if field.ItemSeparator != 0 {
+			schema.ItemSeparator = string(field.ItemSeparator)
+		}
+
+		return schema
+	case kind == reflect.Map:
+		return &JSONSchema{
+			Type:                 "object",
+			AdditionalProperties: getTypeSchema(fieldType.Elem(), &FieldInfo{Base: field.Base}),
+		}
+	case kind == reflect.Pointer:
+		return getTypeSchema(fieldType.Elem(), field)
+	default:
+		return &JSONSchema{Type: "string"}
+	}
+}
+
+// getSchemaDefault returns the default value of the field converted to the schema type, if possible.
+func getSchemaDefault(schema *JSONSchema, field *FieldInfo) any {
+	switch schema.Type {
+	case "boolean":
+		if value, ok := ParseBool(field.DefaultValue, false); ok {
+			return value
+		}
+	case "integer":
+		if value, err := strconv.ParseInt(field.DefaultValue, 0, 64); err == nil {
+			return value
+		}
+	case "number":
+		if value, err := strconv.ParseFloat(field.DefaultValue, 64); err == nil {
+			return value
+		}
+	case "array":
+		return strings.Split(field.DefaultValue, string(field.ItemSeparator))
+	}
+
+	return field.DefaultValue
+}
@@ -0,0 +1,75 @@
+package format
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("RenderJSONSchema", func() {
+	ginkgo.It("should render the expected schema based on config reflection/tags", func() {
+		actual := testRenderTree(JSONSchemaTreeRenderer{}, &struct {
+			Name    string   `default:"notempty" desc:"The name" key:"name,alias"`
+			Host    string   `url:"host,port"`
+			Enabled bool     `default:"Yes"      key:"enabled"`
+			Count   int      `key:"count"`
+			Color   uint     `base:"16"          key:"color"    optional:""`
+			Tags    []string `key:"tags"         optional:""`
+		}{})
+
+		gomega.Expect(actual).To(gomega.MatchJSON(`{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"title": "mock",
+			"description": "Configuration of the mock service",
+			"type": "object",
+			"properties": {
+				"url": {
+					"description": "The fields that are part of the service URL",
+					"type": "object",
+					"properties": {
+						"Host": {"type": "string", "x-url-parts": ["host", "port"]}
+					},
+					"required": ["Host"]
+				},
+				"query": {
+					"description": "The query/param props",
+					"type": "object",
+					"properties": {
+						"color": {"type": "string", "pattern": "^(0x)?[0-9a-fA-F]+$"},
+						"count": {"type": "integer"},
+						"enabled": {"type": "boolean", "default": true},
+						"name": {"description": "The name", "type": "string", "default": "notempty", "x-aliases": ["alias"]},
+						"tags": {"type": "array", "items": {"type": "string"}, "x-separator": ","}
+					},
+					"required": ["count"],
+					"additionalProperties": false
+				}
+			},
+			"required": ["url", "query"]
+		}`))
+	})
+
+	ginkgo.It("should render enum types as strings with the possible values", func() {
+		actual := testRenderTree(JSONSchemaTreeRenderer{}, &testEnummer{})
+
+		gomega.Expect(actual).To(gomega.MatchJSON(`{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"title": "mock",
+			"description": "Configuration of the mock service",
+			"type": "object",
+			"properties": {
+				"url": {
+					"description": "The fields that are part of the service URL",
+					"type": "object"
+				},
+				"query": {
+					"description": "The query/param props",
+					"type": "object",
+					"properties": {
+						"choice": {"type": "string", "enum": ["Yes", "No", "Maybe"], "default": "Maybe"}
+					},
+					"additionalProperties": false
+				}
+			}
+		}`))
+	})
+})
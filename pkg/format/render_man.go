@@ -0,0 +1,168 @@
+package format
+
+import (
+	"strings"
+)
+
+// DefaultManSection is the manual section used by ManTreeRenderer if none is specified.
+const DefaultManSection = "7"
+
+// ManTreeRenderer renders a ContainerNode tree into a roff man page, named shoutrrr-<scheme>.
+type ManTreeRenderer struct {
+	Section           string
+	PropsDescription  string
+	PropsEmptyMessage string
+}
+
+// RenderTree renders a ContainerNode tree into a roff man page.
+func (r ManTreeRenderer) RenderTree(root *ContainerNode, scheme string) string {
+	sb := strings.Builder{}
+
+	section := r.Section
+	if section == "" {
+		section = DefaultManSection
+	}
+
+	urlFields, queryFields := getDocFields(root)
+	name := "shoutrrr-" + scheme
+
+	sb.WriteString(".TH ")
+	sb.WriteString(escapeRoff(strings.ToUpper(name)))
+	sb.WriteString(" " + section + " \"\" \"Shoutrrr\" \"Shoutrrr Services\"\n")
+
+	sb.WriteString(".SH NAME\n")
+	sb.WriteString(escapeRoff(name) + " \\- the " + escapeRoff(scheme) + " notification service URL\n")
+
+	sb.WriteString(".SH SYNOPSIS\n")
+	sb.WriteString(".B " + escapeRoff(getExampleURL(urlFields, queryFields, scheme)) + "\n")
+
+	sb.WriteString(".SH URL FIELDS\n")
+
+	fieldsPrinted := make(map[string]bool)
+
+	for _, field := range urlFields {
+		if field == nil || fieldsPrinted[field.Name] {
+			continue
+		}
+
+		writeManFieldHeader(&sb, field)
+
+		template := strings.Builder{}
+		writeURLTemplate(&template, urlFields, scheme, field, "\x00", "\x01")
+		sb.WriteString(".br\nURL part: ")
+		sb.WriteString(strings.NewReplacer("\x00", "\\fB", "\x01", "\\fR").Replace(escapeRoff(template.String())))
+		sb.WriteRune('\n')
+
+		writeManFieldExtras(&sb, field)
+
+		fieldsPrinted[field.Name] = true
+	}
+
+	sb.WriteString(".SH QUERY/PARAM PROPS\n")
+
+	if len(queryFields) > 0 {
+		writeManParagraph(&sb, r.PropsDescription)
+	} else {
+		writeManParagraph(&sb, r.PropsEmptyMessage)
+	}
+
+	for _, field := range queryFields {
+		writeManFieldHeader(&sb, field)
+
+		if !field.Required {
+			sb.WriteString(".br\nDefault: ")
+
+			if field.DefaultValue == "" {
+				sb.WriteString("\\fIempty\\fR")
+			} else {
+				sb.WriteString("\\fB" + escapeRoff(field.DefaultValue) + "\\fR")
+			}
+
+			sb.WriteRune('\n')
+		}
+
+		writeManFieldExtras(&sb, field)
+	}
+
+	sb.WriteString(".SH SEE ALSO\n")
+	sb.WriteString(".BR shoutrrr (1)\n")
+
+	return sb.String()
+}
+
+// writeManFieldHeader writes a tagged paragraph with the field name as the tag, and the description (if any)
+// and flags as the body.
+func writeManFieldHeader(sb *strings.Builder, field *FieldInfo) {
+	sb.WriteString(".TP\n")
+	sb.WriteString(".B " + escapeRoff(field.Name) + "\n")
+
+	flags := []string{}
+	if field.Required {
+		flags = append(flags, "required")
+	}
+
+	if field.Secret {
+		flags = append(flags, "secret")
+	}
+
+	text := escapeRoff(field.Description)
+	if len(flags) > 0 {
+		text = strings.TrimSpace(text + " (" + strings.Join(flags, ", ") + ")")
+	}
+
+	if text != "" {
+		sb.WriteString(text + "\n")
+	}
+}
+
+func writeManFieldExtras(sb *strings.Builder, field *FieldInfo) {
+	if len(field.Keys) > 1 {
+		writeManList(sb, "Aliases", field.Keys[1:])
+	}
+
+	if formatName := getValueFormatName(field); formatName != "" {
+		sb.WriteString(".br\nFormat: " + escapeRoff(formatName) + "\n")
+	}
+
+	if rules := field.Validation.Rules(); len(rules) > 0 {
+		writeManList(sb, "Validation", rules)
+	}
+
+	if field.EnumFormatter != nil {
+		writeManList(sb, "Possible values", field.EnumFormatter.Names())
+	}
+}
+
+func writeManList(sb *strings.Builder, label string, items []string) {
+	escaped := make([]string, len(items))
+	for i, item := range items {
+		escaped[i] = "\\fB" + escapeRoff(item) + "\\fR"
+	}
+
+	sb.WriteString(".br\n" + label + ": " + strings.Join(escaped, ", ") + "\n")
+}
+
+func writeManParagraph(sb *strings.Builder, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+
+	sb.WriteString(".PP\n")
+
+	for _, line := range strings.Split(text, "\n") {
+		sb.WriteString(escapeRoff(strings.TrimSpace(line)) + "\n")
+	}
+}
+
+// escapeRoff escapes the text so that it is rendered as is by roff, by escaping backslashes and hyphens,
+// and preventing a leading period or apostrophe from being interpreted as a request.
+func escapeRoff(text string) string {
+	text = strings.NewReplacer(`\`, `\e`, "-", `\-`).Replace(text)
+
+	if strings.HasPrefix(text, ".") || strings.HasPrefix(text, "'") {
+		text = `\&` + text
+	}
+
+	return text
+}
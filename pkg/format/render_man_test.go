@@ -0,0 +1,62 @@
+package format
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("RenderMan", func() {
+	ginkgo.It("should render the expected output based on config reflection/tags", func() {
+		actual := testRenderTree(ManTreeRenderer{PropsDescription: "Props description"}, &struct {
+			Name  string `default:".none" desc:"The \\ name" key:"name,alias"`
+			Host  string `url:"host"`
+			Token string `secret:""       url:"path"`
+		}{})
+
+		expected := `
+.TH SHOUTRRR\-MOCK 7 "" "Shoutrrr" "Shoutrrr Services"
+.SH NAME
+shoutrrr\-mock \- the mock notification service URL
+.SH SYNOPSIS
+.B mock://host/token
+.SH URL FIELDS
+.TP
+.B Host
+(required)
+.br
+URL part: mock://\fBhost\fR/token
+.TP
+.B Token
+(required, secret)
+.br
+URL part: mock://host/\fBtoken\fR
+.SH QUERY/PARAM PROPS
+.PP
+Props description
+.TP
+.B Name
+The \e name
+.br
+Default: \fB\&.none\fR
+.br
+Aliases: \fBalias\fR
+.SH SEE ALSO
+.BR shoutrrr (1)
+`[1:]
+
+		gomega.Expect(actual).To(gomega.Equal(expected))
+	})
+
+	ginkgo.It("should list the possible enum values", func() {
+		actual := testRenderTree(ManTreeRenderer{Section: "5"}, &testEnummer{})
+
+		gomega.Expect(actual).To(gomega.HavePrefix(`.TH SHOUTRRR\-MOCK 5 `))
+		gomega.Expect(actual).To(gomega.ContainSubstring("Possible values: \\fBYes\\fR, \\fBNo\\fR, \\fBMaybe\\fR\n"))
+	})
+
+	ginkgo.It("should escape text that would be interpreted as roff requests", func() {
+		gomega.Expect(escapeRoff(".TH")).To(gomega.Equal(`\&.TH`))
+		gomega.Expect(escapeRoff("'quoted'")).To(gomega.Equal(`\&'quoted'`))
+		gomega.Expect(escapeRoff(`a-b\c`)).To(gomega.Equal(`a\-b\ec`))
+	})
+})
@@ -2,7 +2,6 @@ package format
 
 import (
 	"reflect"
-	"sort"
 	"strings"
 )
 
@@ -17,32 +16,10 @@ type MarkdownTreeRenderer struct {
 func (r MarkdownTreeRenderer) RenderTree(root *ContainerNode, scheme string) string {
 	sb := strings.Builder{}
 
-	queryFields := make([]*FieldInfo, 0, len(root.Items))
-	urlFields := make([]*FieldInfo, URLPath+1)
-
-	for _, node := range root.Items {
-		field := node.Field()
-		for _, urlPart := range field.URLParts {
-			if urlPart == URLQuery {
-				queryFields = append(queryFields, field)
-			} else if urlPart > URLPath {
-				urlFields = append(urlFields, field)
-			} else {
-				urlFields[urlPart] = field
-			}
-		}
-
-		if len(field.URLParts) < 1 {
-			queryFields = append(queryFields, field)
-		}
-	}
+	urlFields, queryFields := getDocFields(root)
 
 	r.writeURLFields(&sb, urlFields, scheme)
 
-	sort.SliceStable(queryFields, func(i, j int) bool {
-		return queryFields[i].Required && !queryFields[j].Required
-	})
-
 	r.writeHeader(&sb, "Query/Param Props")
 
 	if len(queryFields) > 0 {
@@ -65,24 +42,6 @@ func (r MarkdownTreeRenderer) RenderTree(root *ContainerNode, scheme string) str
 func (r MarkdownTreeRenderer) writeURLFields(sb *strings.Builder, urlFields []*FieldInfo, scheme string) {
 	fieldsPrinted := make(map[string]bool)
 
-	sort.SliceStable(urlFields, func(i, j int) bool {
-		if urlFields[i] == nil || urlFields[j] == nil {
-			return false
-		}
-
-		urlPartA := URLQuery
-		if len(urlFields[i].URLParts) > 0 {
-			urlPartA = urlFields[i].URLParts[0]
-		}
-
-		urlPartB := URLQuery
-		if len(urlFields[j].URLParts) > 0 {
-			urlPartB = urlFields[j].URLParts[0]
-		}
-
-		return urlPartA < urlPartB
-	})
-
 	r.writeHeader(sb, "URL Fields")
 
 	for _, field := range urlFields {
@@ -93,53 +52,7 @@ func (r MarkdownTreeRenderer) writeURLFields(sb *strings.Builder, urlFields []*F
 		r.writeFieldPrimary(sb, field)
 
 		sb.WriteString("  URL part: <code class=\"service-url\">")
-
-		for i, uf := range urlFields {
-			urlPart := URLPart(i)
-			if urlPart == URLQuery {
-				sb.WriteString(scheme)
-				sb.WriteString("://")
-
-				continue
-			}
-
-			if uf == nil {
-				if urlPart == URLPath {
-					sb.WriteRune(urlPart.Suffix())
-				} else if urlPart == URLHost {
-					// Host cannot be empty
-					if urlFields[URLPassword] != nil || urlFields[URLUser] != nil {
-						sb.WriteRune(URLPassword.Suffix())
-					}
-
-					sb.WriteString(scheme)
-				}
-
-				continue
-			} else if urlPart == URLHost && urlFields[URLUser] == nil && urlFields[URLPassword] == nil {
-			} else if urlPart > URLUser {
-				lastPart := urlPart - 1
-				sb.WriteRune(lastPart.Suffix())
-			}
-
-			if field.IsURLPart(urlPart) {
-				sb.WriteString("<strong>")
-			}
-
-			slug := strings.ToLower(uf.Name)
-
-			// Hard coded override for host:port 😓
-			if slug == "host" && urlPart == URLPort {
-				slug = "port"
-			}
-
-			sb.WriteString(slug)
-
-			if field.IsURLPart(urlPart) {
-				sb.WriteString("</strong>")
-			}
-		}
-
+		writeURLTemplate(sb, urlFields, scheme, field, "<strong>", "</strong>")
 		sb.WriteString("</code>  \n")
 
 		fieldsPrinted[field.Name] = true
@@ -166,6 +79,18 @@ func (MarkdownTreeRenderer) writeFieldExtras(sb *strings.Builder, field *FieldIn
 		sb.WriteString("`  \n")
 	}
 
+	if formatName := getValueFormatName(field); formatName != "" {
+		sb.WriteString("  Format: ")
+		sb.WriteString(formatName)
+		sb.WriteString("  \n")
+	}
+
+	if rules := field.Validation.Rules(); len(rules) > 0 {
+		sb.WriteString("  Validation: `")
+		sb.WriteString(strings.Join(rules, "`, `"))
+		sb.WriteString("`  \n")
+	}
+
 	if field.EnumFormatter != nil {
 		sb.WriteString("  Possible values: `")
 
@@ -193,6 +118,10 @@ func (MarkdownTreeRenderer) writeFieldPrimary(sb *strings.Builder, field *FieldI
 		sb.WriteString(field.Description)
 	}
 
+	if field.Secret {
+		sb.WriteString(" (**Secret**)")
+	}
+
 	if field.Required {
 		sb.WriteString(" (**Required**)  \n")
 	} else {
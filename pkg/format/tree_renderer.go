@@ -1,6 +1,141 @@
 package format
 
+import (
+	"sort"
+	"strings"
+)
+
 // TreeRenderer renders a ContainerNode tree into a string.
 type TreeRenderer interface {
 	RenderTree(root *ContainerNode, scheme string) string
 }
+
+// getDocFields returns the fields of the config tree that are part of the URL, indexed by their URL part (with
+// any additional path parts appended), and the query/param fields, with the required fields first.
+func getDocFields(root *ContainerNode) ([]*FieldInfo, []*FieldInfo) {
+	queryFields := make([]*FieldInfo, 0, len(root.Items))
+	urlFields := make([]*FieldInfo, URLPath+1)
+
+	for _, node := range root.Items {
+		field := node.Field()
+		for _, urlPart := range field.URLParts {
+			if urlPart == URLQuery {
+				queryFields = append(queryFields, field)
+			} else if urlPart > URLPath {
+				urlFields = append(urlFields, field)
+			} else {
+				urlFields[urlPart] = field
+			}
+		}
+
+		if len(field.URLParts) < 1 {
+			queryFields = append(queryFields, field)
+		}
+	}
+
+	sort.SliceStable(urlFields, func(i, j int) bool {
+		if urlFields[i] == nil || urlFields[j] == nil {
+			return false
+		}
+
+		urlPartA := URLQuery
+		if len(urlFields[i].URLParts) > 0 {
+			urlPartA = urlFields[i].URLParts[0]
+		}
+
+		urlPartB := URLQuery
+		if len(urlFields[j].URLParts) > 0 {
+			urlPartB = urlFields[j].URLParts[0]
+		}
+
+		return urlPartA < urlPartB
+	})
+
+	sort.SliceStable(queryFields, func(i, j int) bool {
+		return queryFields[i].Required && !queryFields[j].Required
+	})
+
+	return urlFields, queryFields
+}
+
+// writeURLTemplate writes the URL of the service, using the lower case field names in place of the values.
+// The URL parts of the highlighted field (if any) are wrapped in the highlight start and end strings.
+func writeURLTemplate(
+	sb *strings.Builder,
+	urlFields []*FieldInfo,
+	scheme string,
+	highlighted *FieldInfo,
+	highlightStart string,
+	highlightEnd string,
+) {
+	for i, uf := range urlFields {
+		urlPart := URLPart(i)
+		if urlPart == URLQuery {
+			sb.WriteString(scheme)
+			sb.WriteString("://")
+
+			continue
+		}
+
+		if uf == nil {
+			if urlPart == URLPath {
+				sb.WriteRune(urlPart.Suffix())
+			} else if urlPart == URLHost {
+				// Host cannot be empty
+				if urlFields[URLPassword] != nil || urlFields[URLUser] != nil {
+					sb.WriteRune(URLPassword.Suffix())
+				}
+
+				sb.WriteString(scheme)
+			}
+
+			continue
+		} else if urlPart == URLHost && urlFields[URLUser] == nil && urlFields[URLPassword] == nil {
+		} else if urlPart > URLUser {
+			lastPart := urlPart - 1
+			sb.WriteRune(lastPart.Suffix())
+		}
+
+		isHighlighted := highlighted != nil && highlighted.IsURLPart(urlPart)
+		if isHighlighted {
+			sb.WriteString(highlightStart)
+		}
+
+		slug := strings.ToLower(uf.Name)
+
+		// Hard coded override for host:port 😓
+		if slug == "host" && urlPart == URLPort {
+			slug = "port"
+		}
+
+		sb.WriteString(slug)
+
+		if isHighlighted {
+			sb.WriteString(highlightEnd)
+		}
+	}
+}
+
+// getExampleURL returns the URL template of the service (see writeURLTemplate), with the required query props
+// appended, using their keys in place of the values.
+func getExampleURL(urlFields []*FieldInfo, queryFields []*FieldInfo, scheme string) string {
+	sb := strings.Builder{}
+	writeURLTemplate(&sb, urlFields, scheme, nil, "", "")
+
+	separator := '?'
+
+	for _, field := range queryFields {
+		if !field.Required || len(field.Keys) < 1 {
+			continue
+		}
+
+		sb.WriteRune(separator)
+		sb.WriteString(field.Keys[0])
+		sb.WriteRune('=')
+		sb.WriteString(field.Keys[0])
+
+		separator = '&'
+	}
+
+	return sb.String()
+}
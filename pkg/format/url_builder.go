@@ -0,0 +1,135 @@
+package format
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// BuildURL creates a new config of the same type as the provided config, sets its fields from the props and
+// returns the resulting service URL. The props are keyed by either the field name (case-insensitive), which is
+// used for URL part fields, or by a query prop key or alias. Fields that are not set use their default values.
+// An error is returned if any of the props are unknown or invalid, or if any required fields are missing.
+func BuildURL(config types.ServiceConfig, props map[string]string) (serviceURL *url.URL, err error) {
+	configType := reflect.Indirect(reflect.ValueOf(config)).Type()
+	configPtr := reflect.New(configType)
+	newConfig := configPtr.Interface().(types.ServiceConfig)
+	configValue := configPtr.Elem()
+
+	configNode := GetConfigFormat(newConfig)
+	pkr := NewPropKeyResolver(newConfig)
+
+	if err := pkr.SetDefaultProps(newConfig); err != nil {
+		return nil, fmt.Errorf("failed to set default props: %w", err)
+	}
+
+	fields := make(map[string]*FieldInfo, len(configNode.Items))
+	for _, node := range configNode.Items {
+		field := node.Field()
+		fields[strings.ToLower(field.Name)] = field
+	}
+
+	fieldsSet := make(map[string]bool, len(props))
+	unknownKeys := []string{}
+
+	for _, key := range sortedPropKeys(props) {
+		value := props[key]
+		lowerKey := strings.ToLower(key)
+
+		if field, found := fields[lowerKey]; found {
+			if err := setBuilderField(configValue, field, value); err != nil {
+				return nil, err
+			}
+
+			fieldsSet[field.Name] = true
+
+			continue
+		}
+
+		if field, found := pkr.keyFields[lowerKey]; found {
+			if err := pkr.Set(lowerKey, value); err != nil {
+				return nil, fmt.Errorf("invalid value for %q: %w", key, err)
+			}
+
+			fieldsSet[field.Name] = true
+
+			continue
+		}
+
+		unknownKeys = append(unknownKeys, key)
+	}
+
+	if len(unknownKeys) > 0 {
+		return nil, fmt.Errorf("unknown field(s) or prop key(s): %s", strings.Join(unknownKeys, ", "))
+	}
+
+	missingFields := []string{}
+
+	for _, node := range configNode.Items {
+		field := node.Field()
+		if fieldsSet[field.Name] {
+			continue
+		}
+
+		if field.Required {
+			missingFields = append(missingFields, field.Name)
+		} else if field.DefaultValue != "" && len(field.Keys) < 1 {
+			// Fields without keys do not get their defaults set by the PropKeyResolver
+			if err := setBuilderField(configValue, field, field.DefaultValue); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(missingFields) > 0 {
+		return nil, fmt.Errorf("missing required field(s): %s", strings.Join(missingFields, ", "))
+	}
+
+	return getValidatedURL(newConfig)
+}
+
+func setBuilderField(configValue reflect.Value, field *FieldInfo, value string) error {
+	if err := ValidateFieldValue(field, value); err != nil {
+		return err
+	}
+
+	valid, err := SetConfigField(configValue, *field, value)
+	if err != nil {
+		return fmt.Errorf("invalid value for field %s: %w", field.Name, err)
+	}
+
+	if !valid {
+		return fmt.Errorf("invalid value for field %s: unsupported type %v", field.Name, field.Type)
+	}
+
+	return nil
+}
+
+// getValidatedURL returns the URL for the config, after making sure that the service can parse it.
+func getValidatedURL(config types.ServiceConfig) (*url.URL, error) {
+	serviceURL := config.GetURL()
+	if serviceURL == nil {
+		return nil, fmt.Errorf("failed to create URL from config")
+	}
+
+	if _, err := ParseConfig(config, serviceURL); err != nil {
+		return nil, fmt.Errorf("the resulting URL is invalid: %w", err)
+	}
+
+	return serviceURL, nil
+}
+
+func sortedPropKeys(props map[string]string) []string {
+	keys := make([]string, 0, len(props))
+	for key := range props {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,113 @@
+package format
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+type builderConfig struct {
+	Host  string `pattern:"^[a-z.]+$" url:"host"`
+	Token string `url:"user"`
+	Path  string `default:"/api"      url:"path"`
+	Level int    `default:"1"         key:"level,lvl" max:"5"`
+	Name  string `key:"name"          optional:""`
+}
+
+var errMissingHost = errors.New("host missing from URL")
+
+func (c *builderConfig) GetURL() *url.URL {
+	pkr := NewPropKeyResolver(c)
+
+	return &url.URL{
+		Scheme:   "mock",
+		User:     url.User(c.Token),
+		Host:     c.Host,
+		Path:     c.Path,
+		RawQuery: BuildQuery(&pkr),
+	}
+}
+
+func (c *builderConfig) SetURL(serviceURL *url.URL) error {
+	if serviceURL.Host == "" {
+		return errMissingHost
+	}
+
+	pkr := NewPropKeyResolver(c)
+	c.Host = serviceURL.Host
+	c.Token = serviceURL.User.Username()
+	c.Path = serviceURL.Path
+
+	for key, vals := range serviceURL.Query() {
+		if err := pkr.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *builderConfig) Enums() map[string]types.EnumFormatter {
+	return nil
+}
+
+var _ = ginkgo.Describe("building URLs", func() {
+	ginkgo.It("should set the URL parts and query props", func() {
+		serviceURL, err := BuildURL(&builderConfig{}, map[string]string{
+			"host":  "example.com",
+			"Token": "secret",
+			"lvl":   "3",
+			"name":  "test",
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(serviceURL.String()).To(gomega.Equal("mock://secret@example.com/api?level=3&name=test"))
+	})
+	ginkgo.It("should use the default values for fields that are not set", func() {
+		serviceURL, err := BuildURL(&builderConfig{}, map[string]string{"host": "example.com", "token": "secret"})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(serviceURL.Path).To(gomega.Equal("/api"))
+		gomega.Expect(serviceURL.RawQuery).To(gomega.BeEmpty())
+	})
+	ginkgo.It("should escape the values", func() {
+		serviceURL, err := BuildURL(&builderConfig{}, map[string]string{
+			"host":  "example.com",
+			"token": "se/cr@t",
+			"name":  "a&b c",
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(serviceURL.String()).To(gomega.Equal("mock://se%2Fcr%40t@example.com/api?name=a%26b+c"))
+	})
+	ginkgo.It("should not modify the provided config", func() {
+		config := &builderConfig{Host: "original.com"}
+		_, err := BuildURL(config, map[string]string{"host": "example.com", "token": "secret"})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(config.Host).To(gomega.Equal("original.com"))
+	})
+	ginkgo.DescribeTable("should return an error for invalid props",
+		func(props map[string]string, expected string) {
+			serviceURL, err := BuildURL(&builderConfig{}, props)
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(expected)))
+			gomega.Expect(serviceURL).To(gomega.BeNil())
+		},
+		ginkgo.Entry("unknown keys", map[string]string{"host": "example.com", "token": "t", "color": "red"},
+			"unknown field(s) or prop key(s): color"),
+		ginkgo.Entry("missing required fields", map[string]string{"name": "test"},
+			"missing required field(s): Host, Token"),
+		ginkgo.Entry("values failing validation", map[string]string{"host": "Example.com", "token": "t"},
+			"does not match the pattern"),
+		ginkgo.Entry("props failing validation", map[string]string{"host": "example.com", "token": "t", "level": "9"},
+			"invalid value for Level: value must be at most 5"),
+		ginkgo.Entry("props of the wrong type", map[string]string{"host": "example.com", "token": "t", "level": "high"},
+			"invalid value for Level"),
+		ginkgo.Entry("prop aliases of the wrong type", map[string]string{"host": "example.com", "token": "t", "lvl": "high"},
+			`invalid value for "lvl"`),
+	)
+	ginkgo.It("should return an error if the service cannot parse the resulting URL", func() {
+		_, err := getValidatedURL(&builderConfig{Token: "secret"})
+		gomega.Expect(err).To(gomega.MatchError(errMissingHost))
+	})
+})
@@ -29,6 +29,18 @@ func (u URLPart) Suffix() rune {
 	}
 }
 
+// Tag returns the string representation of the URLPart, as used in the `url` struct tag.
+func (u URLPart) Tag() string {
+	switch {
+	case u > URLPath:
+		return "path" + strconv.Itoa(int(u-URLPath)+1)
+	case u < URLQuery:
+		return URLQuery.Tag()
+	default:
+		return strings.ToLower(u.String())
+	}
+}
+
 // indicator as to what part of an URL a field is serialized to.
 const (
 	URLQuery URLPart = iota
@@ -28,4 +28,13 @@ var _ = ginkgo.Describe("URLPart", func() {
 		gomega.Expect(format.URLPort.Suffix()).To(gomega.Equal('/'))
 		gomega.Expect(format.URLPath.Suffix()).To(gomega.Equal('/'))
 	})
+	ginkgo.It("should return a tag that parses back to the same URL part", func() {
+		for _, tag := range []string{"query", "user", "password", "host", "port", "path", "path2", "path3"} {
+			part := format.ParseURLPart(tag)
+			gomega.Expect(format.ParseURLPart(part.Tag())).To(gomega.Equal(part))
+		}
+
+		gomega.Expect(format.URLPath.Tag()).To(gomega.Equal("path"))
+		gomega.Expect((format.URLPath + 1).Tag()).To(gomega.Equal("path2"))
+	})
 })
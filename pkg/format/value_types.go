@@ -0,0 +1,188 @@
+package format
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// TimeLayout is the layout used to represent time.Time field values.
+const TimeLayout = time.RFC3339
+
+// Value formats of the value types that have no JSON Schema format equivalent.
+const (
+	ValueFormatByteSize = "byte-size"
+)
+
+// valueType is a field type with a string representation of its own, that is de-/serialized as a single
+// value instead of using the field kind.
+type valueType struct {
+	// name is used to describe the value format in the docs
+	name string
+	// token is the token type used when rendering values of the type
+	token NodeTokenType
+	// format returns the string representation of the value
+	format func(value reflect.Value) string
+	// parse returns the value represented by the string, where an empty string is the zero value
+	parse func(input string) (reflect.Value, error)
+	// size returns the numeric value used by the min and max validation rules, and its description
+	size func(value reflect.Value) (float64, string)
+	// schemaFormat is the JSON Schema format of the string representation
+	schemaFormat string
+	// valueFormat is used when there is no equivalent JSON Schema format
+	valueFormat string
+}
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+	byteSizeType = reflect.TypeOf(types.ByteSize(0))
+	urlType      = reflect.TypeOf(url.URL{})
+	urlPtrType   = reflect.TypeOf(&url.URL{})
+)
+
+var valueTypes = map[reflect.Type]valueType{
+	durationType: {
+		name:  "Go duration (e.g. 1m30s)",
+		token: NumberToken,
+		format: func(value reflect.Value) string {
+			return time.Duration(value.Int()).String()
+		},
+		parse: func(input string) (reflect.Value, error) {
+			if input == "" {
+				return reflect.ValueOf(time.Duration(0)), nil
+			}
+
+			duration, err := time.ParseDuration(input)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("%q is not a valid duration", input)
+			}
+
+			return reflect.ValueOf(duration), nil
+		},
+		size: func(value reflect.Value) (float64, string) {
+			return time.Duration(value.Int()).Seconds(), "duration in seconds"
+		},
+		valueFormat: FormatDuration,
+	},
+	timeType: {
+		name:  "RFC 3339 time (e.g. 2006-01-02T15:04:05Z)",
+		token: StringToken,
+		format: func(value reflect.Value) string {
+			timeValue, _ := value.Interface().(time.Time)
+			if timeValue.IsZero() {
+				return ""
+			}
+
+			return timeValue.Format(TimeLayout)
+		},
+		parse: func(input string) (reflect.Value, error) {
+			if input == "" {
+				return reflect.ValueOf(time.Time{}), nil
+			}
+
+			timeValue, err := time.Parse(TimeLayout, input)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("%q is not a valid RFC 3339 time", input)
+			}
+
+			return reflect.ValueOf(timeValue), nil
+		},
+		schemaFormat: "date-time",
+	},
+	byteSizeType: {
+		name:  "byte size (e.g. 512KiB or 10MB)",
+		token: NumberToken,
+		format: func(value reflect.Value) string {
+			return types.ByteSize(value.Uint()).String()
+		},
+		parse: func(input string) (reflect.Value, error) {
+			size, err := types.ParseByteSize(input)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			return reflect.ValueOf(size), nil
+		},
+		size: func(value reflect.Value) (float64, string) {
+			return float64(value.Uint()), "size in bytes"
+		},
+		valueFormat: ValueFormatByteSize,
+	},
+	urlType: {
+		name:  "absolute URL",
+		token: StringToken,
+		format: func(value reflect.Value) string {
+			urlValue, _ := value.Interface().(url.URL)
+
+			return urlValue.String()
+		},
+		parse: func(input string) (reflect.Value, error) {
+			parsed, err := parseURLValue(input)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			return reflect.ValueOf(*parsed), nil
+		},
+		schemaFormat: "uri",
+	},
+	urlPtrType: {
+		name:  "absolute URL",
+		token: StringToken,
+		format: func(value reflect.Value) string {
+			if value.IsNil() {
+				return ""
+			}
+
+			urlValue, _ := value.Interface().(*url.URL)
+
+			return urlValue.String()
+		},
+		parse: func(input string) (reflect.Value, error) {
+			if input == "" {
+				return reflect.Zero(urlPtrType), nil
+			}
+
+			parsed, err := parseURLValue(input)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			return reflect.ValueOf(parsed), nil
+		},
+		schemaFormat: "uri",
+	},
+}
+
+// getValueType returns the value type for the field type, if it has a string representation of its own.
+func getValueType(fieldType reflect.Type) (valueType, bool) {
+	vt, found := valueTypes[fieldType]
+
+	return vt, found
+}
+
+// getValueFormatName returns the description of the value format of the field, if it has a value type.
+func getValueFormatName(field *FieldInfo) string {
+	if vt, isValueType := getValueType(field.Type); isValueType {
+		return vt.name
+	}
+
+	return ""
+}
+
+func parseURLValue(input string) (*url.URL, error) {
+	if input == "" {
+		return &url.URL{}, nil
+	}
+
+	parsed, err := url.Parse(input)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("%q is not a valid absolute URL", input)
+	}
+
+	return parsed, nil
+}
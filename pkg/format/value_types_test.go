@@ -0,0 +1,161 @@
+package format
+
+import (
+	"math"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+type valueTypesConfig struct {
+	Timeout  time.Duration  `default:"30s"  key:"timeout" max:"3600" min:"1"`
+	Expires  time.Time      `key:"expires"  optional:""`
+	MaxSize  types.ByteSize `default:"1MiB" key:"maxsize"`
+	Callback *url.URL       `key:"callback" optional:""`
+	Proxy    url.URL        `key:"proxy"    optional:""`
+}
+
+func (c *valueTypesConfig) GetURL() *url.URL {
+	pkr := NewPropKeyResolver(c)
+
+	return &url.URL{Scheme: "mock", Host: "host", RawQuery: BuildQuery(&pkr)}
+}
+
+func (c *valueTypesConfig) SetURL(_ *url.URL) error {
+	return nil
+}
+
+func (c *valueTypesConfig) Enums() map[string]types.EnumFormatter {
+	return nil
+}
+
+var _ = ginkgo.Describe("Value types", func() {
+	var (
+		config *valueTypesConfig
+		pkr    PropKeyResolver
+	)
+
+	ginkgo.BeforeEach(func() {
+		config = &valueTypesConfig{}
+		pkr = NewPropKeyResolver(config)
+		gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("should set the default values", func() {
+		gomega.Expect(config.Timeout).To(gomega.Equal(30 * time.Second))
+		gomega.Expect(config.MaxSize).To(gomega.Equal(types.Mebibyte))
+		gomega.Expect(config.Expires.IsZero()).To(gomega.BeTrue())
+		gomega.Expect(config.Callback).To(gomega.BeNil())
+	})
+
+	ginkgo.It("should parse and render the values", func() {
+		gomega.Expect(pkr.Set("timeout", "1m30s")).To(gomega.Succeed())
+		gomega.Expect(pkr.Set("expires", "2026-01-02T15:04:05Z")).To(gomega.Succeed())
+		gomega.Expect(pkr.Set("maxsize", "10 MB")).To(gomega.Succeed())
+		gomega.Expect(pkr.Set("callback", "https://example.com/cb?a=1")).To(gomega.Succeed())
+		gomega.Expect(pkr.Set("proxy", "http://proxy:3128")).To(gomega.Succeed())
+
+		gomega.Expect(config.Timeout).To(gomega.Equal(90 * time.Second))
+		gomega.Expect(config.Expires).To(gomega.Equal(time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)))
+		gomega.Expect(config.MaxSize).To(gomega.Equal(10 * types.Megabyte))
+		gomega.Expect(config.Callback.Host).To(gomega.Equal("example.com"))
+		gomega.Expect(config.Proxy.Port()).To(gomega.Equal("3128"))
+
+		gomega.Expect(config.GetURL().Query()).To(gomega.Equal(url.Values{
+			"timeout":  {"1m30s"},
+			"expires":  {"2026-01-02T15:04:05Z"},
+			"maxsize":  {"10MB"},
+			"callback": {"https://example.com/cb?a=1"},
+			"proxy":    {"http://proxy:3128"},
+		}))
+	})
+
+	ginkgo.It("should omit values that are equal to the defaults", func() {
+		gomega.Expect(pkr.Set("timeout", "0.5m")).To(gomega.Succeed())
+		gomega.Expect(pkr.Set("maxsize", "1024KiB")).To(gomega.Succeed())
+		gomega.Expect(config.GetURL().RawQuery).To(gomega.BeEmpty())
+	})
+
+	ginkgo.DescribeTable("should reject invalid values",
+		func(key string, value string, errMatcher gomega.OmegaMatcher) {
+			gomega.Expect(pkr.Set(key, value)).To(gomega.MatchError(errMatcher))
+		},
+		ginkgo.Entry("duration without unit", "timeout", "30", gomega.ContainSubstring(`"30" is not a valid duration`)),
+		ginkgo.Entry("duration out of range", "timeout", "2h",
+			gomega.Equal("invalid value for Timeout: duration in seconds must be at most 3600")),
+		ginkgo.Entry("invalid time", "expires", "tomorrow", gomega.ContainSubstring("is not a valid RFC 3339 time")),
+		ginkgo.Entry("invalid size unit", "maxsize", "10 parsecs", gomega.ContainSubstring(`invalid byte size unit "parsecs"`)),
+		ginkgo.Entry("relative URL", "callback", "/cb", gomega.ContainSubstring("is not a valid absolute URL")),
+	)
+
+	ginkgo.It("should render the value formats in the JSON Schema", func() {
+		schema := JSONSchemaTreeRenderer{}.Schema(GetConfigFormat(config), "mock")
+		query := schema.Properties["query"].Properties
+
+		gomega.Expect(query["timeout"].Type).To(gomega.Equal("string"))
+		gomega.Expect(query["timeout"].ValueFormat).To(gomega.Equal(FormatDuration))
+		gomega.Expect(query["timeout"].Default).To(gomega.Equal("30s"))
+		gomega.Expect(query["expires"].Format).To(gomega.Equal("date-time"))
+		gomega.Expect(query["maxsize"].ValueFormat).To(gomega.Equal(ValueFormatByteSize))
+		gomega.Expect(query["callback"].Format).To(gomega.Equal("uri"))
+		gomega.Expect(query["proxy"].Format).To(gomega.Equal("uri"))
+	})
+
+	ginkgo.It("should render the values in the node tree", func() {
+		config.Timeout = 2 * time.Minute
+
+		for _, node := range GetConfigFormat(config).Items {
+			if node.Field().Name == "Timeout" {
+				gomega.Expect(node.(*ValueNode).Value).To(gomega.Equal("2m0s"))
+				gomega.Expect(node.(*ValueNode).tokenType).To(gomega.Equal(NumberToken))
+			}
+		}
+
+		value, err := GetConfigFieldString(reflect.ValueOf(config).Elem(), FieldInfo{Name: "Callback", Type: urlPtrType})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(value).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should describe the value formats in the docs", func() {
+		markdown := testRenderTree(MarkdownTreeRenderer{}, config)
+		gomega.Expect(markdown).To(gomega.ContainSubstring("  Format: Go duration (e.g. 1m30s)  \n"))
+
+		html := testRenderTree(HTMLTreeRenderer{}, config)
+		gomega.Expect(html).To(gomega.ContainSubstring("<p>Format: byte size (e.g. 512KiB or 10MB)</p>\n"))
+	})
+
+	ginkgo.DescribeTable("byte sizes",
+		func(input string, size types.ByteSize, formatted string) {
+			parsed, err := types.ParseByteSize(input)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(parsed).To(gomega.Equal(size))
+			gomega.Expect(parsed.String()).To(gomega.Equal(formatted))
+		},
+		ginkgo.Entry("without unit", "512", types.ByteSize(512), "512B"),
+		ginkgo.Entry("empty", "", types.ByteSize(0), "0B"),
+		ginkgo.Entry("decimal unit", "10MB", 10*types.Megabyte, "10MB"),
+		ginkgo.Entry("binary unit", "2GiB", 2*types.Gibibyte, "2GiB"),
+		ginkgo.Entry("fraction", "1.5KiB", types.ByteSize(1536), "1536B"),
+		ginkgo.Entry("lower case unit", "4kb", 4*types.Kilobyte, "4KB"),
+		ginkgo.Entry("largest exact unit", "1024KiB", types.Mebibyte, "1MiB"),
+		ginkgo.Entry("fraction of a larger unit", "0.5KB", types.ByteSize(500), "500B"),
+		ginkgo.Entry("largest size", "18446744073709551615", types.ByteSize(math.MaxUint64), "18446744073709551615B"),
+	)
+
+	ginkgo.DescribeTable("invalid byte sizes",
+		func(input string, errMatcher gomega.OmegaMatcher) {
+			_, err := types.ParseByteSize(input)
+			gomega.Expect(err).To(gomega.MatchError(errMatcher))
+		},
+		ginkgo.Entry("fraction of a byte", "1.5B", gomega.ContainSubstring("not a whole number of bytes")),
+		ginkgo.Entry("fraction of a byte in a larger unit", "0.0001KB", gomega.ContainSubstring("not a whole number of bytes")),
+		ginkgo.Entry("overflowing size", "99999999TiB", gomega.ContainSubstring("it is larger than")),
+		ginkgo.Entry("overflowing size without unit", "18446744073709551616", gomega.ContainSubstring("it is larger than")),
+		ginkgo.Entry("invalid number", "1.2.3KB", gomega.ContainSubstring(`invalid byte size "1.2.3KB"`)),
+	)
+})
@@ -6,6 +6,7 @@ import (
 
 	"github.com/nicholas-fedor/shoutrrr/pkg/generators/basic"
 	"github.com/nicholas-fedor/shoutrrr/pkg/generators/xouath2"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/signal"
 	"github.com/nicholas-fedor/shoutrrr/pkg/services/telegram"
 	t "github.com/nicholas-fedor/shoutrrr/pkg/types"
 )
@@ -13,6 +14,7 @@ import (
 var generatorMap = map[string]func() t.Generator{
 	"basic":    func() t.Generator { return &basic.Generator{} },
 	"oauth2":   func() t.Generator { return &xouath2.Generator{} },
+	"signal":   func() t.Generator { return &signal.Generator{} },
 	"telegram": func() t.Generator { return &telegram.Generator{} },
 }
 
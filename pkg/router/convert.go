@@ -0,0 +1,106 @@
+package router
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// webhookHosts maps the hosts of known native webhook URLs to the service that handles them.
+var webhookHosts = map[string]string{
+	"discord.com":         "discord",
+	"discordapp.com":      "discord",
+	"ptb.discord.com":     "discord",
+	"canary.discord.com":  "discord",
+	"hooks.slack.com":     "slack",
+	"chat.googleapis.com": "googlechat",
+	"open.feishu.cn":      "lark",
+	"open.larksuite.com":  "lark",
+	"oapi.dingtalk.com":   "dingtalk",
+	"qyapi.weixin.qq.com": "wecom",
+}
+
+// webhookHostSuffixes maps the host suffixes of known native webhook URLs to the service that handles them.
+var webhookHostSuffixes = map[string]string{
+	".webhook.office.com":    "teams",
+	".logic.azure.com":       "teams",
+	".api.powerplatform.com": "teams",
+}
+
+// DetectWebhookService returns the scheme of the service that handles the native webhook URL.
+// URLs that are not recognized are handled by the generic webhook service.
+func DetectWebhookService(webhookURL *url.URL) string {
+	host := strings.ToLower(webhookURL.Hostname())
+
+	if scheme, found := webhookHosts[host]; found {
+		return scheme
+	}
+
+	for suffix, scheme := range webhookHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return scheme
+		}
+	}
+
+	// Mattermost is self-hosted, so it can only be identified by its incoming webhook path
+	if strings.HasPrefix(webhookURL.Path, "/hooks/") {
+		return "mattermost"
+	}
+
+	return "generic"
+}
+
+// ConvertFromWebhookURL converts a native webhook URL into the corresponding service URL.
+// If serviceScheme is empty, the service is detected from the webhook URL.
+func ConvertFromWebhookURL(rawURL string, serviceScheme string) (*url.URL, error) {
+	webhookURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse webhook URL: %w", err)
+	}
+
+	if webhookURL.Scheme != "http" && webhookURL.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported webhook URL scheme %q, expected http or https", webhookURL.Scheme)
+	}
+
+	if serviceScheme == "" {
+		serviceScheme = DetectWebhookService(webhookURL)
+	}
+
+	service, err := newService(serviceScheme)
+	if err != nil {
+		return nil, err
+	}
+
+	customURLService, ok := service.(types.CustomURLService)
+	if !ok {
+		return nil, fmt.Errorf("webhook URLs are not supported by '%s' service", serviceScheme)
+	}
+
+	customURL := *webhookURL
+	customURL.Scheme = serviceScheme + "+" + webhookURL.Scheme
+
+	return customURLService.GetConfigURLFromCustom(&customURL)
+}
+
+// ConvertToWebhookURL converts a service URL into the corresponding native webhook URL.
+func ConvertToWebhookURL(rawURL string) (*url.URL, error) {
+	service, err := (&ServiceRouter{}).initService(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookConfig, ok := format.GetServiceConfig(service).(types.WebhookConfig)
+	if !ok {
+		return nil, fmt.Errorf("webhook URLs are not supported by '%s' service", service.GetID())
+	}
+
+	webhookURL := webhookConfig.WebhookURL()
+	if webhookURL == nil {
+		return nil, fmt.Errorf("the '%s' service URL does not use a webhook", service.GetID())
+	}
+
+	return webhookURL, nil
+}
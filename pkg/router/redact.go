@@ -0,0 +1,77 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// redactingLogger removes the secret values of the service config from all log output of the service.
+type redactingLogger struct {
+	logger  types.StdLogger
+	service types.Service
+}
+
+func newRedactingLogger(logger types.StdLogger, service types.Service) types.StdLogger {
+	if logger == nil {
+		return nil
+	}
+
+	return &redactingLogger{logger: logger, service: service}
+}
+
+func (rl *redactingLogger) Print(args ...any) {
+	rl.logger.Print(rl.redact(fmt.Sprint(args...)))
+}
+
+func (rl *redactingLogger) Printf(format string, args ...any) {
+	rl.logger.Print(rl.redact(fmt.Sprintf(format, args...)))
+}
+
+func (rl *redactingLogger) Println(args ...any) {
+	rl.logger.Print(rl.redact(fmt.Sprintln(args...)))
+}
+
+func (rl *redactingLogger) redact(text string) string {
+	return format.RedactSecrets(format.GetServiceConfig(rl.service), text)
+}
+
+// redactServiceError removes the secret values of the service config from the error message.
+func redactServiceError(service types.Service, err error) error {
+	if err == nil || service == nil {
+		return err
+	}
+
+	return format.RedactError(format.GetServiceConfig(service), err)
+}
+
+// redactInitError removes the secret values from errors returned when initializing the service. Since the
+// config is likely to be only partially set when initialization fails, all of the user info, path and query
+// values of the config URL are removed as well.
+func redactInitError(service types.Service, configURL *url.URL, err error) error {
+	return format.RedactURLError(configURL, redactServiceError(service, err))
+}
+
+// redactParseError removes the URL from URL parsing errors, since it's likely to contain secrets.
+func redactParseError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Errorf("failed to parse URL: %w", urlErr.Err)
+	}
+
+	return err
+}
+
+// redactServiceURL returns the service URL with the secret values masked, without initializing the service.
+func redactServiceURL(service types.Service, serviceURL *url.URL) string {
+	config := format.GetServiceConfig(service)
+
+	if err := config.SetURL(serviceURL); err != nil {
+		return format.RedactUnknownURL(serviceURL)
+	}
+
+	return format.RedactConfigURL(config)
+}
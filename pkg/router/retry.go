@@ -0,0 +1,76 @@
+package router
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// DefaultRetryDelay is the default duration waited before the first retry of a failed send.
+const DefaultRetryDelay = time.Second
+
+// ErrSendTimeout is returned if a service did not finish sending within the router timeout. Since the
+// service could still deliver the notification after the timeout, sends that timed out are not retried.
+var ErrSendTimeout = errors.New("timed out")
+
+// RateLimiter spaces out send attempts, so that at most one is started per interval.
+// A single limiter can be shared between routers to limit the combined rate of all of them.
+type RateLimiter struct {
+	mutex    sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// NewRateLimiter creates a RateLimiter that allows the specified number of send attempts per second.
+func NewRateLimiter(perSecond float64) *RateLimiter {
+	return &RateLimiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// Wait blocks until the next send attempt is allowed to start. Waiting on a nil limiter returns immediately.
+func (limiter *RateLimiter) Wait() {
+	if limiter == nil {
+		return
+	}
+
+	limiter.mutex.Lock()
+
+	start := time.Now()
+	if limiter.next.After(start) {
+		start = limiter.next
+	}
+
+	limiter.next = start.Add(limiter.interval)
+	limiter.mutex.Unlock()
+
+	time.Sleep(time.Until(start))
+}
+
+// sendWithRetries sends the message using the service, waiting on the routers rate limiter before each attempt,
+// and retrying up to router.Retries times if it fails. The delay between attempts is doubled after each retry.
+// Attempts that time out are not retried, since the abandoned send is still running, and the service would
+// otherwise be used concurrently and could deliver the notification twice.
+func (router *ServiceRouter) sendWithRetries(service types.Service, message string, params types.Params) error {
+	delay := router.RetryDelay
+
+	for attempt := 0; ; attempt++ {
+		router.RateLimiter.Wait()
+
+		err := sendWithTimeout(service, router.Timeout, message, params)
+		if err == nil || attempt >= router.Retries {
+			return err
+		}
+
+		if errors.Is(err, ErrSendTimeout) {
+			router.log("Not retrying, since the send timed out and could still succeed")
+
+			return err
+		}
+
+		router.log("Retrying in", delay, "after error:", err)
+		time.Sleep(delay)
+
+		delay *= 2
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
 	"github.com/nicholas-fedor/shoutrrr/pkg/types"
 )
 
@@ -18,13 +20,20 @@ type ServiceRouter struct {
 	services []types.Service
 	queue    []string
 	Timeout  time.Duration
+	// Retries is the number of times that sending to a service is retried after failing, no retries are made if 0.
+	Retries int
+	// RetryDelay is the duration waited before the first retry, which is doubled for each subsequent retry.
+	RetryDelay time.Duration
+	// RateLimiter, if set, is waited on before each attempt to send to a service.
+	RateLimiter *RateLimiter
 }
 
 // New creates a new service router using the specified logger and service URLs.
 func New(logger types.StdLogger, serviceURLs ...string) (*ServiceRouter, error) {
 	router := ServiceRouter{
-		logger:  logger,
-		Timeout: DefaultTimeout,
+		logger:     logger,
+		Timeout:    DefaultTimeout,
+		RetryDelay: DefaultRetryDelay,
 	}
 
 	for _, serviceURL := range serviceURLs {
@@ -46,6 +55,14 @@ func (router *ServiceRouter) AddService(serviceURL string) error {
 	return err
 }
 
+// Services returns the routers underlying services, in the order that they were added.
+func (router *ServiceRouter) Services() []types.Service {
+	services := make([]types.Service, len(router.services))
+	copy(services, router.services)
+
+	return services
+}
+
 // Send sends the specified message using the routers underlying services.
 func (router *ServiceRouter) Send(message string, params *types.Params) []error {
 	if router == nil {
@@ -97,7 +114,9 @@ func (router *ServiceRouter) SendAsync(message string, params *types.Params) cha
 	}
 
 	for _, service := range router.services {
-		go sendToService(service, proxy, router.Timeout, message, *params)
+		go func(service types.Service, params types.Params) {
+			proxy <- router.sendWithRetries(service, message, params)
+		}(service, *params)
 	}
 
 	go func() {
@@ -111,8 +130,43 @@ func (router *ServiceRouter) SendAsync(message string, params *types.Params) cha
 	return errors
 }
 
-func sendToService(service types.Service, results chan error, timeout time.Duration, message string, params types.Params) {
-	result := make(chan error)
+// SendWithResults sends the specified message using the routers underlying services, and returns a SendResult
+// for each of them, in the same order as the services were added.
+func (router *ServiceRouter) SendWithResults(message string, params *types.Params) []SendResult {
+	results := make([]SendResult, len(router.services))
+
+	if params == nil {
+		params = &types.Params{}
+	}
+
+	wg := sync.WaitGroup{}
+
+	for i, service := range router.services {
+		wg.Add(1)
+
+		go func(index int, service types.Service, params types.Params) {
+			defer wg.Done()
+
+			start := time.Now()
+			err := router.sendWithRetries(service, message, params)
+
+			results[index] = SendResult{
+				Index:     index,
+				ServiceID: service.GetID(),
+				URL:       format.RedactURL(service),
+				Err:       err,
+				Duration:  time.Since(start),
+			}
+		}(i, service, *params)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+func sendWithTimeout(service types.Service, timeout time.Duration, message string, params types.Params) error {
+	result := make(chan error, 1)
 
 	serviceID := service.GetID()
 
@@ -120,9 +174,9 @@ func sendToService(service types.Service, results chan error, timeout time.Durat
 
 	select {
 	case res := <-result:
-		results <- res
+		return redactServiceError(service, res)
 	case <-time.After(timeout):
-		results <- fmt.Errorf("failed to send using %v: timed out", serviceID)
+		return fmt.Errorf("failed to send using %v: %w", serviceID, ErrSendTimeout)
 	}
 }
 
@@ -146,7 +200,7 @@ func (router *ServiceRouter) Flush(params *types.Params) {
 func (router *ServiceRouter) SetLogger(logger types.StdLogger) {
 	router.logger = logger
 	for _, service := range router.services {
-		service.SetLogger(logger)
+		service.SetLogger(newRedactingLogger(logger, service))
 	}
 }
 
@@ -180,7 +234,7 @@ func (router *ServiceRouter) Route(rawURL string, message string) error {
 func (router *ServiceRouter) initService(rawURL string) (types.Service, error) {
 	scheme, configURL, err := router.ExtractServiceName(rawURL)
 	if err != nil {
-		return nil, err
+		return nil, redactParseError(err)
 	}
 
 	service, err := newService(scheme)
@@ -189,29 +243,96 @@ func (router *ServiceRouter) initService(rawURL string) (types.Service, error) {
 	}
 
 	if configURL.Scheme != scheme {
-		router.log("Got custom URL:", configURL.String())
+		router.log("Got custom URL:", format.RedactUnknownURL(configURL))
 
-		customURLService, ok := service.(types.CustomURLService)
-		if !ok {
-			return nil, fmt.Errorf("custom URLs are not supported by '%s' service", scheme)
-		}
+		customURL := configURL
 
-		configURL, err = customURLService.GetConfigURLFromCustom(configURL)
+		configURL, err = getConfigURLFromCustom(service, scheme, customURL)
 		if err != nil {
-			return nil, err
+			return nil, format.RedactURLError(customURL, err)
 		}
 
-		router.log("Converted service URL:", configURL.String())
+		router.log("Converted service URL:", redactServiceURL(service, configURL))
 	}
 
-	err = service.Initialize(configURL, router.logger)
+	err = service.Initialize(configURL, newRedactingLogger(router.logger, service))
 	if err != nil {
-		return service, err
+		return service, redactInitError(service, configURL, err)
+	}
+
+	if err := format.ValidateConfig(format.GetServiceConfig(service)); err != nil {
+		return service, redactServiceError(service, fmt.Errorf("invalid %s config: %w", scheme, err))
 	}
 
 	return service, nil
 }
 
+func getConfigURLFromCustom(service types.Service, scheme string, customURL *url.URL) (*url.URL, error) {
+	customURLService, ok := service.(types.CustomURLService)
+	if !ok {
+		return nil, fmt.Errorf("custom URLs are not supported by '%s' service", scheme)
+	}
+
+	return customURLService.GetConfigURLFromCustom(customURL)
+}
+
+// ConfigURL returns the URL that the service for the notification URL is initialized with.
+// Custom URLs (like teams+https://...) are converted to service URLs, other URLs are returned as is.
+func (router *ServiceRouter) ConfigURL(rawURL string) (*url.URL, error) {
+	_, configURL, err := router.locateConfigURL(rawURL)
+
+	return configURL, err
+}
+
+// locateConfigURL returns a new, uninitialized service for the notification URL and its config URL.
+func (router *ServiceRouter) locateConfigURL(rawURL string) (types.Service, *url.URL, error) {
+	scheme, configURL, err := router.ExtractServiceName(rawURL)
+	if err != nil {
+		return nil, nil, redactParseError(err)
+	}
+
+	service, err := newService(scheme)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if configURL.Scheme != scheme {
+		customURL := configURL
+
+		if configURL, err = getConfigURLFromCustom(service, scheme, customURL); err != nil {
+			return nil, nil, format.RedactURLError(customURL, err)
+		}
+	}
+
+	return service, configURL, nil
+}
+
+// ParseConfig returns the config for the service URL, without initializing the service.
+// Custom URLs (like teams+https://...) are converted to service URLs first.
+func (router *ServiceRouter) ParseConfig(rawURL string) (types.ServiceConfig, error) {
+	service, configURL, err := router.locateConfigURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := format.ParseConfig(format.GetServiceConfig(service), configURL)
+	if err != nil {
+		return nil, redactServiceError(service, err)
+	}
+
+	return config, nil
+}
+
+// Normalize returns the canonical form of the service URL. See format.Normalize for details.
+func (router *ServiceRouter) Normalize(rawURL string) (*url.URL, error) {
+	config, err := router.ParseConfig(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return format.Normalize(config, config.GetURL())
+}
+
 // NewService returns a new uninitialized service instance.
 func (*ServiceRouter) NewService(serviceScheme string) (types.Service, error) {
 	return newService(serviceScheme)
@@ -227,6 +348,55 @@ func newService(serviceScheme string) (types.Service, error) {
 	return serviceFactory(), nil
 }
 
+// BuildURL creates a service URL for the service with the specified scheme from a map of field names and
+// query prop keys to their values. See format.BuildURL for details.
+func BuildURL(serviceScheme string, props map[string]string) (*url.URL, error) {
+	service, err := newService(serviceScheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return format.BuildURL(format.GetServiceConfig(service), props)
+}
+
+// GetEnvPrefix returns the prefix of the environment variables used for configuring the service with the
+// specified scheme, e.g. SHOUTRRR_TELEGRAM for telegram.
+func GetEnvPrefix(serviceScheme string) string {
+	return format.EnvPrefix + "_" + strings.ToUpper(serviceScheme)
+}
+
+// LocateFromEnv initializes the service with the specified scheme from the environment variables named
+// SHOUTRRR_<SERVICE>_<FIELD> (see format.GetEnvVarNames), and returns it together with its canonical URL.
+func (router *ServiceRouter) LocateFromEnv(serviceScheme string) (types.Service, *url.URL, error) {
+	service, err := newService(serviceScheme)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceURL, err := format.BuildURLFromEnv(format.GetServiceConfig(service), GetEnvPrefix(serviceScheme), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	service, err = router.initService(serviceURL.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return service, serviceURL, nil
+}
+
+// AddServiceFromEnv initializes the service with the specified scheme from environment variables (see
+// LocateFromEnv), and adds it if no errors occur.
+func (router *ServiceRouter) AddServiceFromEnv(serviceScheme string) error {
+	service, _, err := router.LocateFromEnv(serviceScheme)
+	if err == nil {
+		router.services = append(router.services, service)
+	}
+
+	return err
+}
+
 // ListServices returns the available services.
 func (router *ServiceRouter) ListServices() []string {
 	services := make([]string, len(serviceMap))
@@ -0,0 +1,19 @@
+package router
+
+import "time"
+
+// SendResult is the outcome of sending a message using one of the routers services.
+type SendResult struct {
+	// Index is the position of the service in the router, matching the order the services were added in.
+	Index     int
+	ServiceID string
+	// URL is the service URL, with the values of all secret fields masked, to tell services of the same kind apart.
+	URL      string
+	Err      error
+	Duration time.Duration
+}
+
+// Success returns whether the message was sent without any errors.
+func (result SendResult) Success() bool {
+	return result.Err == nil
+}
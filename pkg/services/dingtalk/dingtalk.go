@@ -0,0 +1,156 @@
+// Package dingtalk implements DingTalk custom robots as a shoutrrr service.
+package dingtalk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
+)
+
+// Service sends notifications to a DingTalk group using a custom robot.
+type Service struct {
+	standard.Standard
+	Config *Config
+	pkr    format.PropKeyResolver
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier.
+func (service *Service) GetID() string {
+	return Scheme
+}
+
+// GetConfigURLFromCustom creates a regular service URL from a native webhook URL,
+// e.g. dingtalk+https://oapi.dingtalk.com/robot/send?access_token=TOKEN.
+func (*Service) GetConfigURLFromCustom(customURL *url.URL) (*url.URL, error) {
+	token := customURL.Query().Get("access_token")
+	if customURL.Path != sendPath || token == "" {
+		return nil, fmt.Errorf("invalid webhook URL %q, expected %s?access_token=TOKEN", customURL.Path, sendPath)
+	}
+
+	config := &Config{}
+	pkr := format.NewPropKeyResolver(config)
+
+	if err := pkr.SetDefaultProps(config); err != nil {
+		return nil, err
+	}
+
+	config.Token = token
+	config.Host = customURL.Host
+
+	return config.getURL(&pkr), nil
+}
+
+// Send a notification message to the DingTalk group of the custom robot.
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this message
+	config := *service.Config
+
+	if err := service.pkr.UpdateConfigFromParams(&config, params); err != nil {
+		return err
+	}
+
+	webhookURL := config.WebhookURL()
+
+	if config.Secret != "" {
+		timestamp := time.Now().UnixMilli()
+		query := webhookURL.Query()
+		query.Set("timestamp", strconv.FormatInt(timestamp, 10))
+		query.Set("sign", sign(config.Secret, timestamp))
+		webhookURL.RawQuery = query.Encode()
+	}
+
+	res := response{}
+	jsonClient := jsonclient.NewClient()
+
+	if err := jsonClient.Post(webhookURL.String(), createPayload(&config, message), &res); err != nil {
+		if jsonClient.ErrorResponse(err, &res) && res.ErrCode != 0 {
+			// response implements Error
+			return fmt.Errorf("failed to send dingtalk notification: %w", &res)
+		}
+
+		return fmt.Errorf("failed to send dingtalk notification: %w", err)
+	}
+
+	if res.ErrCode != 0 {
+		return fmt.Errorf("failed to send dingtalk notification: %w", &res)
+	}
+
+	return nil
+}
+
+func createPayload(config *Config, text string) message {
+	payload := message{}
+
+	mobiles := util.NonEmpty(config.Mobiles)
+	userIDs := util.NonEmpty(config.UserIDs)
+
+	// Mentions are only highlighted if the message contains them
+	for _, mention := range append(append([]string{}, mobiles...), userIDs...) {
+		if !strings.Contains(text, "@"+mention) {
+			text += " @" + mention
+		}
+	}
+
+	if len(mobiles) > 0 || len(userIDs) > 0 || config.AtAll {
+		payload.At = &at{
+			AtMobiles: mobiles,
+			AtUserIDs: userIDs,
+			IsAtAll:   config.AtAll,
+		}
+	}
+
+	if config.MsgType == MsgTypeMarkdown {
+		// The title is only used for the notification preview, so it is added to the text as well
+		title := config.Title
+		if title == "" {
+			title, _, _ = strings.Cut(text, "\n")
+		} else {
+			text = fmt.Sprintf("#### %s\n\n%s", title, text)
+		}
+
+		payload.MsgType = "markdown"
+		payload.Markdown = &markdownContent{Title: title, Text: text}
+
+		return payload
+	}
+
+	if config.Title != "" {
+		text = config.Title + "\n" + text
+	}
+
+	payload.MsgType = "text"
+	payload.Text = &textContent{Content: text}
+
+	return payload
+}
+
+// sign returns the signature of a webhook request, which is the HMAC-SHA256 of the timestamp (in milliseconds)
+// and the secret separated by a newline, keyed with the secret.
+func sign(secret string, timestamp int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "\n" + secret))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,91 @@
+package dingtalk
+
+import (
+	"net/url"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// sendPath is the path of the robot webhooks, which take the access token as a query param.
+const sendPath = "/robot/send"
+
+// Config for the DingTalk service.
+type Config struct {
+	Token   string   `desc:"Robot webhook access token"                                            required:""                                        secret:""          url:"user"`
+	Host    string   `default:"oapi.dingtalk.com"                                                  desc:"Webhook host"                                url:"host"`
+	Secret  string   `desc:"Signing secret (SEC...), if the signature security setting is enabled" key:"secret"                                       optional:""        secret:""`
+	MsgType msgType  `default:"Text"                                                               desc:"Send the messages as plain Text or Markdown" key:"msgtype,type"`
+	Title   string   `default:""                                                                   desc:"Notification title, shown above the message" key:"title"`
+	Mobiles []string `desc:"Phone numbers of the users to mention"                                 key:"mobiles"                                      optional:""`
+	UserIDs []string `desc:"User IDs of the users to mention"                                      key:"userids"                                      optional:""`
+	AtAll   bool     `default:"No"                                                                 desc:"Mention everyone in the group"               key:"atall"`
+}
+
+// Enums returns the fields that should use a corresponding EnumFormatter to Print/Parse their values.
+func (config *Config) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"MsgType": MsgType.Enum,
+	}
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+// WebhookURL returns the native robot webhook URL for the config.
+func (config *Config) WebhookURL() *url.URL {
+	return &url.URL{
+		Scheme:   "https",
+		Host:     config.Host,
+		Path:     sendPath,
+		RawQuery: url.Values{"access_token": []string{config.Token}}.Encode(),
+	}
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	return &url.URL{
+		User:     url.User(config.Token),
+		Host:     config.Host,
+		Scheme:   Scheme,
+		RawQuery: format.BuildQuery(resolver),
+	}
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	config.Token = url.User.Username()
+	config.Host = url.Host
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	if url.String() != "dingtalk://dummy@dummy.com" {
+		if config.Token == "" {
+			return ErrorMissingToken
+		}
+
+		if config.Host == "" {
+			return ErrorMissingHost
+		}
+	}
+
+	return nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "dingtalk"
+)
@@ -0,0 +1,11 @@
+package dingtalk
+
+import "errors"
+
+var (
+	// ErrorMissingToken is returned if the access token is missing from the service URL.
+	ErrorMissingToken = errors.New("missing access token")
+
+	// ErrorMissingHost is returned if the webhook host is missing from the service URL.
+	ErrorMissingHost = errors.New("missing webhook host")
+)
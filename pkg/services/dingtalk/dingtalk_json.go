@@ -0,0 +1,39 @@
+package dingtalk
+
+import "fmt"
+
+// message is the request body posted to the robot webhook.
+//
+// See: https://open.dingtalk.com/document/orgapp/custom-robots-send-group-messages
+type message struct {
+	MsgType  string           `json:"msgtype"`
+	Text     *textContent     `json:"text,omitempty"`
+	Markdown *markdownContent `json:"markdown,omitempty"`
+	At       *at              `json:"at,omitempty"`
+}
+
+type textContent struct {
+	Content string `json:"content"`
+}
+
+type markdownContent struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+// at lists the users that are mentioned by a message.
+type at struct {
+	AtMobiles []string `json:"atMobiles,omitempty"`
+	AtUserIDs []string `json:"atUserIds,omitempty"`
+	IsAtAll   bool     `json:"isAtAll,omitempty"`
+}
+
+// response is the body returned by the robot webhook.
+type response struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (r *response) Error() string {
+	return fmt.Sprintf("api response: %v (errcode %v)", r.ErrMsg, r.ErrCode)
+}
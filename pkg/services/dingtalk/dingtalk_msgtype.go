@@ -0,0 +1,35 @@
+package dingtalk
+
+import (
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Message types as constants.
+const (
+	MsgTypeText     msgType = 0
+	MsgTypeMarkdown msgType = 1
+)
+
+type msgType int
+
+type msgTypeVals struct {
+	Text     msgType
+	Markdown msgType
+	Enum     types.EnumFormatter
+}
+
+// MsgType defines whether the messages are sent as plain text or as markdown.
+var MsgType = &msgTypeVals{
+	Text:     MsgTypeText,
+	Markdown: MsgTypeMarkdown,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"Text",
+			"Markdown",
+		}),
+}
+
+func (t msgType) String() string {
+	return MsgType.Enum.Print(int(t))
+}
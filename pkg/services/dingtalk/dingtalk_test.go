@@ -0,0 +1,195 @@
+package dingtalk
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/internal/testutils"
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+const (
+	mockToken      = "0123456789abcdef"
+	mockWebhookURL = "https://oapi.dingtalk.com/robot/send?access_token=" + mockToken
+)
+
+func TestDingTalk(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Shoutrrr DingTalk Suite")
+}
+
+var (
+	service *Service
+	logger  *log.Logger = testutils.TestLogger()
+)
+
+var _ = ginkgo.Describe("the DingTalk service", func() {
+	ginkgo.BeforeEach(func() {
+		service = &Service{}
+	})
+
+	ginkgo.Describe("the config", func() {
+		ginkgo.When("only required fields are set", func() {
+			ginkgo.It("should set the optional fields to the defaults", func() {
+				serviceURL := testutils.URLMust("dingtalk://" + mockToken + "@oapi.dingtalk.com")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				gomega.Expect(*service.Config).To(gomega.Equal(Config{
+					Token:   mockToken,
+					Host:    "oapi.dingtalk.com",
+					MsgType: MsgTypeText,
+					Mobiles: []string{""},
+					UserIDs: []string{""},
+				}))
+				gomega.Expect(service.Config.WebhookURL().String()).To(gomega.Equal(mockWebhookURL))
+			})
+		})
+		ginkgo.When("the token is missing", func() {
+			ginkgo.It("should return an error", func() {
+				serviceURL := testutils.URLMust("dingtalk://oapi.dingtalk.com")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.MatchError(ErrorMissingToken))
+			})
+		})
+		ginkgo.When("parsing the configuration URL", func() {
+			ginkgo.It("should be identical after de-/serialization", func() {
+				testURL := "dingtalk://" + mockToken + "@oapi.dingtalk.com" +
+					"?atall=Yes&mobiles=13800000000&msgtype=Markdown&secret=SECabc&title=Alert&userids=manager1%2Cmanager2"
+				config := &Config{}
+				pkr := format.NewPropKeyResolver(config)
+				gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+				gomega.Expect(config.setURL(&pkr, testutils.URLMust(testURL))).To(gomega.Succeed(), "verifying")
+				gomega.Expect(config.GetURL().String()).To(gomega.Equal(testURL))
+			})
+		})
+		ginkgo.When("converting a native webhook URL", func() {
+			ginkgo.It("should use the access token and host of the webhook", func() {
+				serviceURL, err := service.GetConfigURLFromCustom(testutils.URLMust("dingtalk+" + mockWebhookURL))
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(serviceURL.String()).To(gomega.Equal("dingtalk://" + mockToken + "@oapi.dingtalk.com"))
+			})
+			ginkgo.It("should return an error if the access token is missing", func() {
+				_, err := service.GetConfigURLFromCustom(testutils.URLMust("dingtalk+https://oapi.dingtalk.com/robot/send"))
+				gomega.Expect(err).To(gomega.HaveOccurred())
+			})
+		})
+	})
+
+	ginkgo.Describe("signing requests", func() {
+		// Computed with the reference implementation from the custom robot security settings documentation
+		ginkgo.It("should match the reference implementation", func() {
+			gomega.Expect(sign("this is secret", 1577262236757)).
+				To(gomega.Equal("hmPWwU+7lVdm3ZZz0r9tSfx0L4Q26jWOZr9+Gs6EZQM="))
+		})
+	})
+
+	ginkgo.Describe("sending messages", func() {
+		var (
+			requestBody  map[string]any
+			requestQuery url.Values
+		)
+
+		ginkgo.BeforeEach(func() {
+			httpmock.Activate()
+
+			requestBody = nil
+			requestQuery = nil
+			httpmock.RegisterResponder("POST", "https://oapi.dingtalk.com/robot/send",
+				func(req *http.Request) (*http.Response, error) {
+					body, err := io.ReadAll(req.Body)
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					gomega.Expect(json.Unmarshal(body, &requestBody)).To(gomega.Succeed())
+
+					requestQuery = req.URL.Query()
+
+					return httpmock.NewStringResponse(http.StatusOK, `{"errcode":0,"errmsg":"ok"}`), nil
+				})
+
+			serviceURL := testutils.URLMust("dingtalk://" + mockToken + "@oapi.dingtalk.com?mobiles=13800000000")
+			gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+		})
+		ginkgo.AfterEach(func() {
+			httpmock.DeactivateAndReset()
+		})
+
+		ginkgo.It("should send a text message with the mentions", func() {
+			gomega.Expect(service.Send("Deploy done", &types.Params{"title": "CI"})).To(gomega.Succeed())
+			gomega.Expect(requestBody).To(gomega.Equal(map[string]any{
+				"msgtype": "text",
+				"text":    map[string]any{"content": "CI\nDeploy done @13800000000"},
+				"at":      map[string]any{"atMobiles": []any{"13800000000"}},
+			}))
+			gomega.Expect(requestQuery).To(gomega.Equal(url.Values{"access_token": {mockToken}}))
+		})
+		ginkgo.It("should send a markdown message with the title as a heading", func() {
+			gomega.Expect(service.Send("Deploy **done** @13800000000", &types.Params{
+				"title":   "CI",
+				"type":    "markdown",
+				"userids": "manager1",
+				"atall":   "yes",
+			})).To(gomega.Succeed())
+			gomega.Expect(requestBody).To(gomega.Equal(map[string]any{
+				"msgtype": "markdown",
+				"markdown": map[string]any{
+					"title": "CI",
+					"text":  "#### CI\n\nDeploy **done** @13800000000 @manager1",
+				},
+				"at": map[string]any{
+					"atMobiles": []any{"13800000000"},
+					"atUserIds": []any{"manager1"},
+					"isAtAll":   true,
+				},
+			}))
+		})
+		ginkgo.It("should use the first line as the markdown title if no title is set", func() {
+			gomega.Expect(service.Send("Deploy done\nAll checks passed", &types.Params{"msgtype": "markdown"})).
+				To(gomega.Succeed())
+			gomega.Expect(requestBody["markdown"]).To(gomega.HaveKeyWithValue("title", "Deploy done"))
+		})
+		ginkgo.It("should sign the request if a secret is set", func() {
+			gomega.Expect(service.Send("Deploy done", &types.Params{"secret": "SECabc"})).To(gomega.Succeed())
+
+			timestamp, err := strconv.ParseInt(requestQuery.Get("timestamp"), 10, 64)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(requestQuery.Get("sign")).To(gomega.Equal(sign("SECabc", timestamp)))
+			gomega.Expect(requestQuery.Get("access_token")).To(gomega.Equal(mockToken))
+		})
+		ginkgo.It("should return the errors reported by the webhook", func() {
+			httpmock.RegisterResponder("POST", "https://oapi.dingtalk.com/robot/send",
+				httpmock.NewStringResponder(http.StatusOK, `{"errcode":310000,"errmsg":"sign not match"}`))
+			gomega.Expect(service.Send("Deploy done", nil)).To(gomega.MatchError(
+				"failed to send dingtalk notification: api response: sign not match (errcode 310000)"))
+		})
+	})
+
+	ginkgo.Describe("the basic service API", func() {
+		ginkgo.Describe("the service config", func() {
+			ginkgo.It("should implement basic service config API methods correctly", func() {
+				testutils.TestConfigGetInvalidQueryValue(&Config{})
+				testutils.TestConfigSetInvalidQueryValue(&Config{}, "dingtalk://token@host?foo=bar")
+				testutils.TestConfigSetDefaultValues(&Config{})
+				testutils.TestConfigGetEnumsCount(&Config{}, 1)
+				testutils.TestConfigGetFieldsCount(&Config{}, 7)
+			})
+		})
+		ginkgo.Describe("the service instance", func() {
+			ginkgo.It("should implement basic service API methods correctly", func() {
+				serviceURL := testutils.URLMust("dingtalk://" + mockToken + "@host")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				testutils.TestServiceSetInvalidParamValue(service, "foo", "bar")
+			})
+		})
+	})
+
+	ginkgo.It("should return the correct service ID", func() {
+		gomega.Expect(service.GetID()).To(gomega.Equal("dingtalk"))
+	})
+})
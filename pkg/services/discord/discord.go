@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/nicholas-fedor/shoutrrr/pkg/format"
 	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
@@ -29,6 +31,9 @@ const (
 	HooksBaseURL   = "https://discord.com/api/webhooks"
 )
 
+// webhookPathParts is the number of path segments in a webhook URL, starting from "webhooks".
+const webhookPathParts = 3
+
 var limits = types.MessageLimit{
 	ChunkSize:      ChunkSize,
 	TotalChunkSize: TotalChunkSize,
@@ -139,6 +144,29 @@ func (service *Service) GetID() string {
 	return Scheme
 }
 
+// GetConfigURLFromCustom creates a regular service URL from a native webhook URL,
+// e.g. discord+https://discord.com/api/webhooks/WEBHOOKID/TOKEN.
+func (*Service) GetConfigURLFromCustom(customURL *url.URL) (*url.URL, error) {
+	path := strings.Split(strings.Trim(customURL.Path, "/"), "/")
+
+	hooksIndex := slices.Index(path, "webhooks")
+	if hooksIndex < 0 || len(path) != hooksIndex+webhookPathParts {
+		return nil, fmt.Errorf("invalid webhook URL path %q, expected /api/webhooks/WEBHOOKID/TOKEN", customURL.Path)
+	}
+
+	config := &Config{}
+	pkr := format.NewPropKeyResolver(config)
+
+	if err := pkr.SetDefaultProps(config); err != nil {
+		return nil, err
+	}
+
+	config.WebhookID = path[hooksIndex+1]
+	config.Token = path[hooksIndex+2]
+
+	return config.getURL(&pkr), nil
+}
+
 // CreateAPIURLFromConfig takes a discord config object and creates a post url.
 func CreateAPIURLFromConfig(config *Config) string {
 	return fmt.Sprintf(
@@ -13,7 +13,7 @@ import (
 type Config struct {
 	standard.EnumlessConfig
 	WebhookID  string `url:"host"`
-	Token      string `url:"user"`
+	Token      string `secret:""     url:"user"`
 	Title      string `default:""    key:"title"`
 	Username   string `default:""    desc:"Override the webhook default username"                                                            key:"username"`
 	Avatar     string `default:""    desc:"Override the webhook default avatar with specified URL"                                           key:"avatar,avatarurl"`
@@ -67,6 +67,13 @@ func (config *Config) getURL(resolver types.ConfigQueryResolver) (u *url.URL) {
 	return u
 }
 
+// WebhookURL returns the native Discord webhook URL for the config.
+func (config *Config) WebhookURL() *url.URL {
+	webhookURL, _ := url.Parse(CreateAPIURLFromConfig(config))
+
+	return webhookURL
+}
+
 // SetURL updates a ServiceConfig from a URL representation of it's field values.
 func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
 	config.WebhookID = url.Host
@@ -77,3 +77,16 @@ func CreatePayloadFromItems(items []types.MessageItem, title string, colors [typ
 		Embeds: embeds,
 	}, nil
 }
+
+// webhookInfo is the subset of the webhook object returned by the API that is used for verification.
+type webhookInfo struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ChannelID string `json:"channel_id"`
+}
+
+// errorResponse is returned by the API when a request fails.
+type errorResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
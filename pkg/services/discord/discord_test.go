@@ -234,6 +234,18 @@ var _ = ginkgo.Describe("the discord service", func() {
 			gomega.Expect(service.Initialize(dummyConfig.GetURL(), logger)).To(gomega.Succeed())
 			gomega.Expect(service.Send("", nil)).NotTo(gomega.Succeed())
 		})
+		ginkgo.When("verifying the configuration", func() {
+			ginkgo.It("should not report an error if the webhook exists", func() {
+				httpmock.RegisterResponder("GET", discord.CreateAPIURLFromConfig(&dummyConfig),
+					httpmock.NewStringResponder(200, `{"id":"1","name":"hook","channel_id":"2"}`))
+				gomega.Expect(service.Verify()).To(gomega.Succeed())
+			})
+			ginkgo.It("should report the reason if the webhook is not accepted", func() {
+				httpmock.RegisterResponder("GET", discord.CreateAPIURLFromConfig(&dummyConfig),
+					httpmock.NewStringResponder(401, `{"message":"Invalid Webhook Token","code":50027}`))
+				gomega.Expect(service.Verify()).To(gomega.MatchError("webhook was not accepted: Invalid Webhook Token (50027)"))
+			})
+		})
 		ginkgo.When("using a custom json payload", func() {
 			ginkgo.It("should report an error if the server response is not OK", func() {
 				config := dummyConfig
@@ -0,0 +1,31 @@
+package discord
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
+)
+
+// verifyClient is used for the verification requests.
+var verifyClient = &http.Client{Timeout: types.VerifyTimeout}
+
+// Verify checks that the webhook exists and that the token is valid, without sending any messages.
+func (service *Service) Verify() error {
+	client := jsonclient.NewWithHTTPClient(verifyClient)
+	info := &webhookInfo{}
+
+	if err := client.Get(CreateAPIURLFromConfig(service.Config), info); err != nil {
+		errorRes := &errorResponse{}
+		if client.ErrorResponse(err, errorRes) && errorRes.Message != "" {
+			return fmt.Errorf("webhook was not accepted: %v (%v)", errorRes.Message, errorRes.Code)
+		}
+
+		return fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	service.Logf("Found webhook %q for channel %v", info.Name, info.ChannelID)
+
+	return nil
+}
@@ -0,0 +1,176 @@
+// Package file implements a shoutrrr service that appends the notifications to a local file, as NDJSON records
+// or (templated) text lines.
+package file
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+const (
+	fileMode = 0o644
+	dirMode  = 0o755
+)
+
+// Service appends notifications to a local file.
+type Service struct {
+	standard.Standard
+	Config *Config
+	pkr    format.PropKeyResolver
+	mutex  sync.Mutex
+	now    func() time.Time
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+	service.now = time.Now
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier.
+func (service *Service) GetID() string {
+	return Scheme
+}
+
+// ExtraParamKeys returns AnyParamKey, since params that are not config props are added to the record.
+func (*Service) ExtraParamKeys() []string {
+	return []string{types.AnyParamKey}
+}
+
+// Send appends the notification to the file, rotating it first if needed.
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this notification
+	config := *service.Config
+	props, extraParams := service.splitParams(params)
+
+	if err := service.pkr.UpdateConfigFromParams(&config, &props); err != nil {
+		return err
+	}
+
+	record := &Record{
+		Timestamp: service.now().UTC(),
+		Level:     config.Level,
+		Title:     getTitle(params),
+		Message:   message,
+		Params:    extraParams,
+	}
+
+	line, err := service.createLine(&config, record)
+	if err != nil {
+		return err
+	}
+
+	// Concurrent sends from the same service are written one at a time, to keep the rotation consistent
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	if err := service.rotateIfNeeded(&config, len(line), record.Timestamp); err != nil {
+		return err
+	}
+
+	return writeLine(&config, line)
+}
+
+// splitParams splits the params into the ones that set config props, and the other ones, which are added to the
+// record. The title and message are not included in either.
+func (service *Service) splitParams(params *types.Params) (types.Params, map[string]string) {
+	props := types.Params{}
+	extraParams := map[string]string{}
+
+	if params == nil {
+		return props, extraParams
+	}
+
+	propKeys := service.pkr.QueryFields()
+
+	for key, value := range *params {
+		switch {
+		case slices.Contains(propKeys, strings.ToLower(key)):
+			props[key] = value
+		case key != types.TitleKey && key != types.MessageKey:
+			extraParams[key] = value
+		}
+	}
+
+	return props, extraParams
+}
+
+func getTitle(params *types.Params) string {
+	if params == nil {
+		return ""
+	}
+
+	title, _ := params.Title()
+
+	return title
+}
+
+func (service *Service) rotateIfNeeded(config *Config, lineSize int, now time.Time) error {
+	if config.MaxSize == 0 && config.MaxAge == 0 {
+		return nil
+	}
+
+	info, err := os.Stat(config.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to check file: %w", err)
+	}
+
+	if !needsRotation(config, info, lineSize, now) {
+		return nil
+	}
+
+	service.Logf("Rotating %s", config.Path)
+
+	return rotate(config, info)
+}
+
+func writeLine(config *Config, line []byte) error {
+	if err := os.MkdirAll(filepath.Dir(config.Path), dirMode); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	file, err := os.OpenFile(config.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, fileMode)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+
+	if _, err := file.Write(line); err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("failed to write to file: %w", err)
+	}
+
+	if config.Sync {
+		if err := file.Sync(); err != nil {
+			_ = file.Close()
+
+			return fmt.Errorf("failed to sync file: %w", err)
+		}
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+
+	return nil
+}
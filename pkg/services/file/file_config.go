@@ -0,0 +1,82 @@
+package file
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Config for the file service.
+type Config struct {
+	Path       string         `desc:"Path of the file, absolute (file:///var/log/notify.ndjson) or relative (file://notify.ndjson)" required:""                                                                                                   url:"host,path"`
+	Format     recordFormat   `default:"JSON"                                                                                       desc:"Write the notifications as JSON records (NDJSON) or Text lines"                                         key:"format"`
+	Template   string         `desc:"ID of the template used for Text lines"                                                        key:"template"                                                                                                optional:""`
+	Level      string         `default:"info"                                                                                       desc:"Level of the notifications"                                                                             key:"level"`
+	MaxSize    types.ByteSize `default:"0B"                                                                                         desc:"Rotate the file before it exceeds this size, 0 disables rotation by size"                               key:"maxsize"`
+	MaxAge     time.Duration  `default:"0s"                                                                                         desc:"Rotate the file when a notification falls into a new period of this length, 0 disables rotation by age" key:"maxage"`
+	MaxBackups uint           `default:"0"                                                                                          desc:"Number of rotated files to keep, 0 keeps all of them"                                                   key:"maxbackups"`
+	Sync       bool           `default:"No"                                                                                         desc:"Flush every notification to disk (fsync) before returning"                                              key:"fsync,sync"`
+}
+
+// Enums returns the fields that should use a corresponding EnumFormatter to Print/Parse their values.
+func (config *Config) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"Format": RecordFormat.Enum,
+	}
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	fileURL := &url.URL{
+		Scheme:   Scheme,
+		Path:     config.Path,
+		RawQuery: format.BuildQuery(resolver),
+	}
+
+	// Relative paths start in the host part of the URL
+	if !strings.HasPrefix(config.Path, "/") {
+		fileURL.Host, fileURL.Path, _ = strings.Cut(config.Path, "/")
+		if fileURL.Path != "" {
+			fileURL.Path = "/" + fileURL.Path
+		}
+	}
+
+	return fileURL
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	config.Path = url.Host + url.Path
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	if url.String() != "file://dummy@dummy.com" && config.Path == "" {
+		return ErrorMissingPath
+	}
+
+	return nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "file"
+)
@@ -0,0 +1,6 @@
+package file
+
+import "errors"
+
+// ErrorMissingPath is returned if the path of the file is missing from the service URL.
+var ErrorMissingPath = errors.New("missing file path")
@@ -0,0 +1,37 @@
+package file
+
+import (
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Record formats as constants.
+const (
+	FormatJSON recordFormat = 0
+	FormatText recordFormat = 1
+)
+
+type recordFormat int
+
+type recordFormatVals struct {
+	JSON recordFormat
+	Text recordFormat
+	Enum types.EnumFormatter
+}
+
+// RecordFormat defines whether the notifications are written as NDJSON records or as (templated) text lines.
+var RecordFormat = &recordFormatVals{
+	JSON: FormatJSON,
+	Text: FormatText,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"JSON",
+			"Text",
+		}, map[string]int{
+			"ndjson": int(FormatJSON),
+		}),
+}
+
+func (f recordFormat) String() string {
+	return RecordFormat.Enum.Print(int(f))
+}
@@ -0,0 +1,58 @@
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Record is a single notification, written as a line of NDJSON or passed to the line template.
+type Record struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Level     string            `json:"level"`
+	Title     string            `json:"title,omitempty"`
+	Message   string            `json:"message"`
+	Params    map[string]string `json:"params,omitempty"`
+}
+
+// createLine returns the record formatted as a single line, including the line break.
+func (service *Service) createLine(config *Config, record *Record) ([]byte, error) {
+	if config.Format == FormatJSON {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal record: %w", err)
+		}
+
+		return append(line, '\n'), nil
+	}
+
+	if config.Template == "" {
+		return []byte(formatTextLine(record) + "\n"), nil
+	}
+
+	tpl, found := service.GetTemplate(config.Template)
+	if !found {
+		return nil, fmt.Errorf("template %q has not been loaded", config.Template)
+	}
+
+	sb := &strings.Builder{}
+	if err := tpl.Execute(sb, record); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	// Keep every notification on a single line, regardless of the template
+	return []byte(strings.TrimRight(sb.String(), "\n") + "\n"), nil
+}
+
+// formatTextLine formats the record as "<timestamp> [<level>] <title>: <message>", with line breaks escaped.
+func formatTextLine(record *Record) string {
+	text := record.Message
+	if record.Title != "" {
+		text = record.Title + ": " + text
+	}
+
+	text = strings.NewReplacer("\r", `\r`, "\n", `\n`).Replace(text)
+
+	return fmt.Sprintf("%s [%s] %s", record.Timestamp.Format(time.RFC3339Nano), record.Level, text)
+}
@@ -0,0 +1,110 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// backupTimeFormat is used in the names of the rotated files, which sort in chronological order.
+const backupTimeFormat = "20060102T150405.000"
+
+// needsRotation returns whether the file needs to be rotated before the line is written to it at the time now.
+func needsRotation(config *Config, info os.FileInfo, lineSize int, now time.Time) bool {
+	if info.Size() == 0 {
+		return false
+	}
+
+	if config.MaxSize > 0 && uint64(info.Size())+uint64(lineSize) > uint64(config.MaxSize) {
+		return true
+	}
+
+	// The periods are aligned to the unix epoch, so daily files start at midnight UTC
+	if config.MaxAge > 0 && !info.ModTime().Truncate(config.MaxAge).Equal(now.Truncate(config.MaxAge)) {
+		return true
+	}
+
+	return false
+}
+
+// rotate renames the file to a backup name containing the time of its last modification, and removes the oldest
+// backups exceeding the maximum number.
+func rotate(config *Config, info os.FileInfo) error {
+	backupTime := info.ModTime()
+	backupPath := getBackupPath(config.Path, backupTime)
+
+	// Files rotated within the same millisecond would otherwise replace each other
+	for fileExists(backupPath) {
+		backupTime = backupTime.Add(time.Millisecond)
+		backupPath = getBackupPath(config.Path, backupTime)
+	}
+
+	if err := os.Rename(config.Path, backupPath); err != nil {
+		return fmt.Errorf("failed to rotate file: %w", err)
+	}
+
+	if config.MaxBackups < 1 {
+		return nil
+	}
+
+	backups, err := listBackups(config.Path)
+	if err != nil {
+		return err
+	}
+
+	for len(backups) > int(config.MaxBackups) {
+		if err := os.Remove(backups[0]); err != nil {
+			return fmt.Errorf("failed to remove old file: %w", err)
+		}
+
+		backups = backups[1:]
+	}
+
+	return nil
+}
+
+// getBackupPath returns the path of a rotated file, e.g. notify-20240101T120000.000.ndjson for notify.ndjson.
+func getBackupPath(path string, modTime time.Time) string {
+	ext := filepath.Ext(path)
+
+	return fmt.Sprintf("%s-%s%s", strings.TrimSuffix(path, ext), modTime.UTC().Format(backupTimeFormat), ext)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Lstat(path)
+
+	return err == nil
+}
+
+// listBackups returns the paths of the rotated files, oldest first.
+func listBackups(path string) ([]string, error) {
+	ext := filepath.Ext(path)
+	prefix := strings.TrimSuffix(filepath.Base(path), ext) + "-"
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rotated files: %w", err)
+	}
+
+	backups := []string{}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		timestamp, found := strings.CutPrefix(strings.TrimSuffix(name, ext), prefix)
+
+		if !found || entry.IsDir() || !strings.HasSuffix(name, ext) {
+			continue
+		}
+
+		if _, err := time.Parse(backupTimeFormat, timestamp); err == nil {
+			backups = append(backups, filepath.Join(filepath.Dir(path), name))
+		}
+	}
+
+	slices.Sort(backups)
+
+	return backups, nil
+}
@@ -0,0 +1,214 @@
+package file
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/internal/testutils"
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+func TestFile(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Shoutrrr File Suite")
+}
+
+var (
+	service *Service
+	logger  *log.Logger = testutils.TestLogger()
+)
+
+var mockTime = time.Date(2024, 1, 2, 12, 30, 0, 0, time.UTC)
+
+var _ = ginkgo.Describe("the file service", func() {
+	var dir string
+
+	ginkgo.BeforeEach(func() {
+		service = &Service{}
+		dir = ginkgo.GinkgoT().TempDir()
+	})
+
+	initService := func(path string, query string) {
+		serviceURL := testutils.URLMust("file://" + filepath.ToSlash(filepath.Join(dir, path)) + query)
+		gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+		service.now = func() time.Time { return mockTime }
+	}
+
+	readLines := func(path string) []string {
+		content, err := os.ReadFile(filepath.Join(dir, path))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		return strings.SplitAfter(strings.TrimSuffix(string(content), "\n"), "\n")
+	}
+
+	ginkgo.Describe("the config", func() {
+		ginkgo.When("only required fields are set", func() {
+			ginkgo.It("should set the optional fields to the defaults", func() {
+				gomega.Expect(service.Initialize(testutils.URLMust("file:///var/log/notify.ndjson"), logger)).
+					To(gomega.Succeed())
+				gomega.Expect(*service.Config).To(gomega.Equal(Config{
+					Path:   "/var/log/notify.ndjson",
+					Format: FormatJSON,
+					Level:  "info",
+				}))
+			})
+		})
+		ginkgo.When("the path is missing", func() {
+			ginkgo.It("should return an error", func() {
+				gomega.Expect(service.Initialize(testutils.URLMust("file://"), logger)).
+					To(gomega.MatchError(ErrorMissingPath))
+			})
+		})
+		ginkgo.When("parsing the configuration URL", func() {
+			ginkgo.It("should be identical after de-/serialization", func() {
+				testURL := "file:///var/log/notify.log" +
+					"?format=Text&fsync=Yes&level=warn&maxage=24h0m0s&maxbackups=7&maxsize=10MiB&template=line"
+				config := &Config{}
+				pkr := format.NewPropKeyResolver(config)
+				gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+				gomega.Expect(config.setURL(&pkr, testutils.URLMust(testURL))).To(gomega.Succeed(), "verifying")
+				gomega.Expect(config.GetURL().String()).To(gomega.Equal(testURL))
+			})
+			ginkgo.It("should support relative paths", func() {
+				config := &Config{}
+				pkr := format.NewPropKeyResolver(config)
+				gomega.Expect(config.setURL(&pkr, testutils.URLMust("file://logs/notify.ndjson"))).To(gomega.Succeed())
+				gomega.Expect(config.Path).To(gomega.Equal("logs/notify.ndjson"))
+				gomega.Expect(config.GetURL().String()).To(gomega.HavePrefix("file://logs/notify.ndjson"))
+			})
+		})
+	})
+
+	ginkgo.Describe("writing notifications", func() {
+		ginkgo.It("should append NDJSON records with the title, level and params", func() {
+			initService("audit/notify.ndjson", "")
+			gomega.Expect(service.Send("Disk is full", &types.Params{
+				"title": "db-1",
+				"level": "error",
+				"host":  "db-1.example.com",
+			})).To(gomega.Succeed())
+			gomega.Expect(service.Send("Disk space freed", nil)).To(gomega.Succeed())
+			gomega.Expect(readLines("audit/notify.ndjson")).To(gomega.Equal([]string{
+				`{"timestamp":"2024-01-02T12:30:00Z","level":"error","title":"db-1","message":"Disk is full",` +
+					`"params":{"host":"db-1.example.com"}}` + "\n",
+				`{"timestamp":"2024-01-02T12:30:00Z","level":"info","message":"Disk space freed"}`,
+			}))
+		})
+		ginkgo.It("should write text lines with escaped line breaks", func() {
+			initService("notify.log", "?format=text")
+			gomega.Expect(service.Send("Disk is full\nOnly 2% left", &types.Params{"title": "db-1"})).
+				To(gomega.Succeed())
+			gomega.Expect(readLines("notify.log")).To(gomega.Equal([]string{
+				`2024-01-02T12:30:00Z [info] db-1: Disk is full\nOnly 2% left`,
+			}))
+		})
+		ginkgo.It("should write text lines using the template", func() {
+			initService("notify.log", "?format=text&template=line")
+			gomega.Expect(service.SetTemplateString("line",
+				`{{.Level | printf "%-5s"}} {{.Message}} host={{index .Params "host"}}`+"\n")).To(gomega.Succeed())
+			gomega.Expect(service.Send("Disk is full", &types.Params{"host": "db-1"})).To(gomega.Succeed())
+			gomega.Expect(readLines("notify.log")).To(gomega.Equal([]string{"info  Disk is full host=db-1"}))
+		})
+		ginkgo.It("should return an error if the template has not been loaded", func() {
+			initService("notify.log", "?format=text&template=line")
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.HaveOccurred())
+		})
+		ginkgo.It("should flush the file if fsync is enabled", func() {
+			initService("notify.ndjson", "?fsync=yes")
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.Succeed())
+			gomega.Expect(readLines("notify.ndjson")).To(gomega.HaveLen(1))
+		})
+	})
+
+	ginkgo.Describe("rotating the file", func() {
+		ginkgo.It("should rotate the file before it exceeds the maximum size", func() {
+			initService("notify.ndjson", "?maxsize=200B")
+
+			for range 3 {
+				gomega.Expect(service.Send("Disk is full", nil)).To(gomega.Succeed())
+			}
+
+			backups, err := listBackups(filepath.Join(dir, "notify.ndjson"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(backups).To(gomega.HaveLen(1))
+			gomega.Expect(readLines("notify.ndjson")).To(gomega.HaveLen(1))
+			gomega.Expect(readLines(filepath.Base(backups[0]))).To(gomega.HaveLen(2))
+		})
+		ginkgo.It("should not replace files rotated at the same time", func() {
+			initService("notify.ndjson", "?maxsize=1B")
+
+			for range 3 {
+				gomega.Expect(service.Send("Disk is full", nil)).To(gomega.Succeed())
+			}
+
+			backups, err := listBackups(filepath.Join(dir, "notify.ndjson"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(backups).To(gomega.HaveLen(2))
+		})
+		ginkgo.It("should rotate the file when a notification falls into a new period", func() {
+			initService("notify.ndjson", "?maxage=24h")
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.Succeed())
+
+			path := filepath.Join(dir, "notify.ndjson")
+			yesterday := mockTime.Add(-24 * time.Hour)
+			gomega.Expect(os.Chtimes(path, yesterday, yesterday)).To(gomega.Succeed())
+
+			gomega.Expect(service.Send("Disk space freed", nil)).To(gomega.Succeed())
+			gomega.Expect(readLines("notify.ndjson")).To(gomega.HaveLen(1))
+			gomega.Expect(readLines("notify-20240101T123000.000.ndjson")).To(gomega.HaveLen(1))
+		})
+		ginkgo.It("should not rotate the file within the same period", func() {
+			initService("notify.ndjson", "?maxage=24h")
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.Succeed())
+
+			path := filepath.Join(dir, "notify.ndjson")
+			earlier := mockTime.Add(-time.Hour)
+			gomega.Expect(os.Chtimes(path, earlier, earlier)).To(gomega.Succeed())
+
+			gomega.Expect(service.Send("Disk space freed", nil)).To(gomega.Succeed())
+			gomega.Expect(readLines("notify.ndjson")).To(gomega.HaveLen(2))
+		})
+		ginkgo.It("should remove the oldest rotated files", func() {
+			initService("notify.ndjson", "?maxage=1h&maxbackups=2")
+			path := filepath.Join(dir, "notify.ndjson")
+
+			for i := range 5 {
+				sent := mockTime.Add(time.Duration(i) * time.Hour)
+				service.now = func() time.Time { return sent }
+				gomega.Expect(service.Send("Disk is full", nil)).To(gomega.Succeed())
+				gomega.Expect(os.Chtimes(path, sent, sent)).To(gomega.Succeed())
+			}
+
+			backups, err := listBackups(path)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(backups).To(gomega.Equal([]string{
+				filepath.Join(dir, "notify-20240102T143000.000.ndjson"),
+				filepath.Join(dir, "notify-20240102T153000.000.ndjson"),
+			}))
+		})
+	})
+
+	ginkgo.Describe("the basic service API", func() {
+		ginkgo.Describe("the service config", func() {
+			ginkgo.It("should implement basic service config API methods correctly", func() {
+				testutils.TestConfigGetInvalidQueryValue(&Config{})
+				testutils.TestConfigSetInvalidQueryValue(&Config{}, "file:///tmp/notify.log?foo=bar")
+				testutils.TestConfigSetDefaultValues(&Config{})
+				testutils.TestConfigGetEnumsCount(&Config{}, 1)
+				testutils.TestConfigGetFieldsCount(&Config{}, 8)
+			})
+		})
+	})
+
+	ginkgo.It("should return the correct service ID", func() {
+		gomega.Expect(service.GetID()).To(gomega.Equal("file"))
+	})
+})
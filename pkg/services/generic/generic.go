@@ -67,6 +67,11 @@ func (service *Service) GetID() string {
 	return Scheme
 }
 
+// ExtraParamKeys returns AnyParamKey, since params that are not config props are added to the payload.
+func (*Service) ExtraParamKeys() []string {
+	return []string{types.AnyParamKey}
+}
+
 // GetConfigURLFromCustom creates a regular service URL from one with a custom host.
 func (*Service) GetConfigURLFromCustom(customURL *url.URL) (serviceURL *url.URL, err error) {
 	webhookURL := *customURL
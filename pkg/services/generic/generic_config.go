@@ -91,8 +91,12 @@ func (config *Config) SetURL(serviceURL *url.URL) error {
 }
 
 func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
-	serviceURL := *config.webhookURL
-	webhookQuery := config.webhookURL.Query()
+	serviceURL := url.URL{}
+	if config.webhookURL != nil {
+		serviceURL = *config.webhookURL
+	}
+
+	webhookQuery := serviceURL.Query()
 	serviceQuery := format.BuildQueryWithCustomFields(resolver, webhookQuery)
 	appendCustomQueryValues(serviceQuery, config.headers, config.extraData)
 	serviceURL.RawQuery = serviceQuery.Encode()
@@ -32,6 +32,20 @@ func (service *Service) GetID() string {
 	return Scheme
 }
 
+// GetConfigURLFromCustom creates a regular service URL from a native webhook URL,
+// e.g. googlechat+https://chat.googleapis.com/v1/spaces/FOO/messages?key=bar&token=baz.
+func (*Service) GetConfigURLFromCustom(customURL *url.URL) (*url.URL, error) {
+	webhookURL := *customURL
+	webhookURL.Scheme = Scheme
+
+	config := &Config{}
+	if err := config.SetURL(&webhookURL); err != nil {
+		return nil, err
+	}
+
+	return config.GetURL(), nil
+}
+
 // Send a notification message to Google Chat.
 func (service *Service) Send(message string, _ *types.Params) error {
 	config := service.Config
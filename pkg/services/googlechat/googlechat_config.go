@@ -13,8 +13,8 @@ type Config struct {
 	standard.EnumlessConfig
 	Host  string `default:"chat.googleapis.com"`
 	Path  string
-	Token string
-	Key   string
+	Token string `secret:""`
+	Key   string `secret:""`
 }
 
 func (config *Config) GetURL() *url.URL {
@@ -29,6 +29,11 @@ func (config *Config) SetURL(url *url.URL) error {
 	return config.setURL(&resolver, url)
 }
 
+// WebhookURL returns the native Google Chat webhook URL for the config.
+func (config *Config) WebhookURL() *url.URL {
+	return getAPIURL(config)
+}
+
 func (config *Config) setURL(_ types.ConfigQueryResolver, serviceURL *url.URL) error {
 	config.Host = serviceURL.Host
 	config.Path = serviceURL.Path
@@ -86,12 +86,16 @@ func buildURL(config *Config) (string, error) {
 		return "", fmt.Errorf("invalid gotify token %q", token)
 	}
 
+	return fmt.Sprintf("%s/message?token=%s", buildBaseURL(config), token), nil
+}
+
+func buildBaseURL(config *Config) string {
 	scheme := "https"
 	if config.DisableTLS {
 		scheme = scheme[:4]
 	}
 
-	return fmt.Sprintf("%s://%s%s/message?token=%s", scheme, config.Host, config.Path, token), nil
+	return fmt.Sprintf("%s://%s%s", scheme, config.Host, config.Path)
 }
 
 // Send a notification message to Gotify.
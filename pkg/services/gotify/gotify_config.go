@@ -13,7 +13,7 @@ import (
 // Config for use within the gotify plugin.
 type Config struct {
 	standard.EnumlessConfig
-	Token      string `desc:"Application token"                     required:""      url:"path2"`
+	Token      string `desc:"Application token"                     required:""      secret:""       url:"path2"`
 	Host       string `desc:"Server hostname (and optionally port)" required:""      url:"host,port"`
 	Path       string `desc:"Server subpath"                        optional:""      url:"path1"`
 	Priority   int    `default:"0"                                  key:"priority"`
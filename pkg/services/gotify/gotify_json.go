@@ -16,6 +16,11 @@ type messageResponse struct {
 	Date  string `json:"date"`
 }
 
+type healthResponse struct {
+	Health   string `json:"health"`
+	Database string `json:"database"`
+}
+
 type errorResponse struct {
 	Name        string `json:"error"`
 	Code        uint64 `json:"errorCode"`
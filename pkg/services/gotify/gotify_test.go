@@ -230,5 +230,29 @@ var _ = ginkgo.Describe("the Gotify service", func() {
 				gomega.Expect(logBuffer.String()).To(gomega.ContainSubstring("Failed to update params"))
 			})
 		})
+		ginkgo.When("verifying the configuration", func() {
+			ginkgo.It("does not report an error if the server is healthy", func() {
+				httpmock.RegisterResponder(
+					"GET",
+					"https://my.gotify.tld/health",
+					testutils.JSONRespondMust(200, map[string]interface{}{
+						"health":   "green",
+						"database": "green",
+					}),
+				)
+				gomega.Expect(service.Verify()).To(gomega.Succeed())
+			})
+			ginkgo.It("reports an error if the server is unhealthy", func() {
+				httpmock.RegisterResponder(
+					"GET",
+					"https://my.gotify.tld/health",
+					testutils.JSONRespondMust(500, map[string]interface{}{
+						"health":   "orange",
+						"database": "red",
+					}),
+				)
+				gomega.Expect(service.Verify()).To(gomega.MatchError(gomega.HavePrefix("failed to get server health")))
+			})
+		})
 	})
 })
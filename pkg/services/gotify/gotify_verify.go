@@ -0,0 +1,24 @@
+package gotify
+
+import "fmt"
+
+const healthGreen = "green"
+
+// Verify checks the format of the application token and that the server reports itself as healthy.
+// Gotify does not provide a way to validate application tokens without sending a message.
+func (service *Service) Verify() error {
+	if !isTokenValid(service.Config.Token) {
+		return fmt.Errorf("invalid gotify token %q", service.Config.Token)
+	}
+
+	response := &healthResponse{}
+	if err := service.client.Get(buildBaseURL(service.Config)+"/health", response); err != nil {
+		return fmt.Errorf("failed to get server health: %w", err)
+	}
+
+	if response.Health != healthGreen || response.Database != healthGreen {
+		return fmt.Errorf("server is unhealthy (health: %v, database: %v)", response.Health, response.Database)
+	}
+
+	return nil
+}
@@ -24,7 +24,7 @@ const (
 // Config is the configuration needed to send IFTTT notifications.
 type Config struct {
 	standard.EnumlessConfig
-	WebHookID         string   `required:"true" url:"host"`
+	WebHookID         string   `required:"true" secret:""                                                             url:"host"`
 	Events            []string `key:"events"    required:"true"`
 	Value1            string   `key:"value1"    optional:""`
 	Value2            string   `key:"value2"    optional:""`
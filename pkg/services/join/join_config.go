@@ -10,7 +10,7 @@ import (
 
 // Config for the Pushover notification service service.
 type Config struct {
-	APIKey  string   `url:"pass"`
+	APIKey  string   `secret:""                                 url:"pass"`
 	Devices []string `desc:"Comma separated list of device IDs" key:"devices"`
 	Title   string   `desc:"If set creates a notification"      key:"title"   optional:""`
 	Icon    string   `desc:"Icon URL"                           key:"icon"    optional:""`
@@ -0,0 +1,150 @@
+// Package lark implements Lark (Feishu) custom bots as a shoutrrr service.
+package lark
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
+)
+
+// postLanguage is the language key used for the rich text of post messages.
+const postLanguage = "en_us"
+
+// Service sends notifications to a Lark/Feishu group using a custom bot.
+type Service struct {
+	standard.Standard
+	Config *Config
+	pkr    format.PropKeyResolver
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier.
+func (service *Service) GetID() string {
+	return Scheme
+}
+
+// GetConfigURLFromCustom creates a regular service URL from a native webhook URL,
+// e.g. lark+https://open.feishu.cn/open-apis/bot/v2/hook/TOKEN.
+func (*Service) GetConfigURLFromCustom(customURL *url.URL) (*url.URL, error) {
+	token, found := strings.CutPrefix(customURL.Path, hookPath)
+	if !found || token == "" || strings.Contains(token, "/") {
+		return nil, fmt.Errorf("invalid webhook URL path %q, expected %sTOKEN", customURL.Path, hookPath)
+	}
+
+	config := &Config{}
+	pkr := format.NewPropKeyResolver(config)
+
+	if err := pkr.SetDefaultProps(config); err != nil {
+		return nil, err
+	}
+
+	config.Token = token
+	config.Host = customURL.Host
+
+	return config.getURL(&pkr), nil
+}
+
+// Send a notification message to the Lark/Feishu group of the custom bot.
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this message
+	config := *service.Config
+
+	if err := service.pkr.UpdateConfigFromParams(&config, params); err != nil {
+		return err
+	}
+
+	payload := createPayload(&config, message)
+
+	if config.Secret != "" {
+		timestamp := time.Now().Unix()
+		payload.Timestamp = strconv.FormatInt(timestamp, 10)
+		payload.Sign = sign(config.Secret, timestamp)
+	}
+
+	res := response{}
+	jsonClient := jsonclient.NewClient()
+
+	if err := jsonClient.Post(config.WebhookURL().String(), payload, &res); err != nil {
+		if jsonClient.ErrorResponse(err, &res) && res.Code != 0 {
+			// response implements Error
+			return fmt.Errorf("failed to send lark notification: %w", &res)
+		}
+
+		return fmt.Errorf("failed to send lark notification: %w", err)
+	}
+
+	if res.Code != 0 {
+		return fmt.Errorf("failed to send lark notification: %w", &res)
+	}
+
+	return nil
+}
+
+func createPayload(config *Config, text string) message {
+	if config.MsgType == MsgTypePost {
+		return message{
+			MsgType: "post",
+			Content: messageContent{Post: map[string]postLocal{postLanguage: createPost(config, text)}},
+		}
+	}
+
+	if config.Title != "" {
+		text = config.Title + "\n" + text
+	}
+
+	for _, userID := range util.NonEmpty(config.Mentions) {
+		text += fmt.Sprintf(` <at user_id="%s"></at>`, userID)
+	}
+
+	return message{
+		MsgType: "text",
+		Content: messageContent{Text: text},
+	}
+}
+
+// createPost creates the rich text of a post message, with a paragraph per line of the message and the mentions
+// appended to the last paragraph.
+func createPost(config *Config, text string) postLocal {
+	lines := strings.Split(text, "\n")
+	paragraphs := make([][]postElement, 0, len(lines))
+
+	for _, line := range lines {
+		paragraphs = append(paragraphs, []postElement{{Tag: "text", Text: line}})
+	}
+
+	last := len(paragraphs) - 1
+	for _, userID := range util.NonEmpty(config.Mentions) {
+		paragraphs[last] = append(paragraphs[last], postElement{Tag: "at", UserID: userID})
+	}
+
+	return postLocal{Title: config.Title, Content: paragraphs}
+}
+
+// sign returns the signature of a webhook request, which is the HMAC-SHA256 of an empty message, keyed with the
+// timestamp (in seconds) and the secret separated by a newline.
+func sign(secret string, timestamp int64) string {
+	mac := hmac.New(sha256.New, []byte(strconv.FormatInt(timestamp, 10)+"\n"+secret))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
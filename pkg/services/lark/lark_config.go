@@ -0,0 +1,88 @@
+package lark
+
+import (
+	"net/url"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// hookPath is the path of the custom bot webhooks, followed by the bot token.
+const hookPath = "/open-apis/bot/v2/hook/"
+
+// Config for the Lark/Feishu service.
+type Config struct {
+	Token    string   `desc:"Custom bot webhook token"                             required:""                                              secret:""          url:"user"`
+	Host     string   `default:"open.feishu.cn"                                    desc:"Webhook host, use open.larksuite.com for Lark"     url:"host"`
+	Secret   string   `desc:"Signing secret, if signature verification is enabled" key:"secret"                                             optional:""        secret:""`
+	MsgType  msgType  `default:"Text"                                              desc:"Send the messages as plain Text or rich text Post" key:"msgtype,type"`
+	Title    string   `default:""                                                  desc:"Notification title, shown above the message"       key:"title"`
+	Mentions []string `desc:"Open IDs (ou_...) of the users to mention, or all"    key:"mentions"                                           optional:""`
+}
+
+// Enums returns the fields that should use a corresponding EnumFormatter to Print/Parse their values.
+func (config *Config) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"MsgType": MsgType.Enum,
+	}
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+// WebhookURL returns the native custom bot webhook URL for the config.
+func (config *Config) WebhookURL() *url.URL {
+	return &url.URL{
+		Scheme: "https",
+		Host:   config.Host,
+		Path:   hookPath + config.Token,
+	}
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	return &url.URL{
+		User:     url.User(config.Token),
+		Host:     config.Host,
+		Scheme:   Scheme,
+		RawQuery: format.BuildQuery(resolver),
+	}
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	config.Token = url.User.Username()
+	config.Host = url.Host
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	if url.String() != "lark://dummy@dummy.com" {
+		if config.Token == "" {
+			return ErrorMissingToken
+		}
+
+		if config.Host == "" {
+			return ErrorMissingHost
+		}
+	}
+
+	return nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "lark"
+)
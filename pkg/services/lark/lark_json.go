@@ -0,0 +1,42 @@
+package lark
+
+import "fmt"
+
+// message is the request body posted to the custom bot webhook.
+//
+// See: https://open.feishu.cn/document/client-docs/bot-v3/add-custom-bot
+type message struct {
+	Timestamp string         `json:"timestamp,omitempty"`
+	Sign      string         `json:"sign,omitempty"`
+	MsgType   string         `json:"msg_type"`
+	Content   messageContent `json:"content"`
+}
+
+// messageContent holds either the text of a text message or the rich text of a post message.
+type messageContent struct {
+	Text string               `json:"text,omitempty"`
+	Post map[string]postLocal `json:"post,omitempty"`
+}
+
+// postLocal is the rich text of a post message in a single language.
+type postLocal struct {
+	Title   string          `json:"title,omitempty"`
+	Content [][]postElement `json:"content"`
+}
+
+// postElement is a text or at (mention) element of a rich text paragraph.
+type postElement struct {
+	Tag    string `json:"tag"`
+	Text   string `json:"text,omitempty"`
+	UserID string `json:"user_id,omitempty"`
+}
+
+// response is the body returned by the custom bot webhook.
+type response struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (r *response) Error() string {
+	return fmt.Sprintf("api response: %v (code %v)", r.Msg, r.Code)
+}
@@ -0,0 +1,35 @@
+package lark
+
+import (
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Message types as constants.
+const (
+	MsgTypeText msgType = 0
+	MsgTypePost msgType = 1
+)
+
+type msgType int
+
+type msgTypeVals struct {
+	Text msgType
+	Post msgType
+	Enum types.EnumFormatter
+}
+
+// MsgType defines whether the messages are sent as plain text or as rich text posts.
+var MsgType = &msgTypeVals{
+	Text: MsgTypeText,
+	Post: MsgTypePost,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"Text",
+			"Post",
+		}),
+}
+
+func (t msgType) String() string {
+	return MsgType.Enum.Print(int(t))
+}
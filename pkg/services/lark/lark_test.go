@@ -0,0 +1,189 @@
+package lark
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/internal/testutils"
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+const (
+	mockToken      = "11111111-2222-3333-4444-555555555555"
+	mockWebhookURL = "https://open.feishu.cn/open-apis/bot/v2/hook/" + mockToken
+)
+
+func TestLark(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Shoutrrr Lark Suite")
+}
+
+var (
+	service *Service
+	logger  *log.Logger = testutils.TestLogger()
+)
+
+var _ = ginkgo.Describe("the Lark service", func() {
+	ginkgo.BeforeEach(func() {
+		service = &Service{}
+	})
+
+	ginkgo.Describe("the config", func() {
+		ginkgo.When("only required fields are set", func() {
+			ginkgo.It("should set the optional fields to the defaults", func() {
+				serviceURL := testutils.URLMust("lark://" + mockToken + "@open.feishu.cn")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				gomega.Expect(*service.Config).To(gomega.Equal(Config{
+					Token:    mockToken,
+					Host:     "open.feishu.cn",
+					MsgType:  MsgTypeText,
+					Mentions: []string{""},
+				}))
+				gomega.Expect(service.Config.WebhookURL().String()).To(gomega.Equal(mockWebhookURL))
+			})
+		})
+		ginkgo.When("the token is missing", func() {
+			ginkgo.It("should return an error", func() {
+				serviceURL := testutils.URLMust("lark://open.feishu.cn")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.MatchError(ErrorMissingToken))
+			})
+		})
+		ginkgo.When("parsing the configuration URL", func() {
+			ginkgo.It("should be identical after de-/serialization", func() {
+				testURL := "lark://" + mockToken + "@open.larksuite.com" +
+					"?mentions=ou_123%2Call&msgtype=Post&secret=s3cr3t&title=Alert"
+				config := &Config{}
+				pkr := format.NewPropKeyResolver(config)
+				gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+				gomega.Expect(config.setURL(&pkr, testutils.URLMust(testURL))).To(gomega.Succeed(), "verifying")
+				gomega.Expect(config.GetURL().String()).To(gomega.Equal(testURL))
+			})
+		})
+		ginkgo.When("converting a native webhook URL", func() {
+			ginkgo.It("should use the token and host of the webhook", func() {
+				serviceURL, err := service.GetConfigURLFromCustom(testutils.URLMust("lark+" + mockWebhookURL))
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(serviceURL.String()).To(gomega.Equal("lark://" + mockToken + "@open.feishu.cn"))
+			})
+			ginkgo.It("should return an error for other paths", func() {
+				_, err := service.GetConfigURLFromCustom(testutils.URLMust("lark+https://open.feishu.cn/foo"))
+				gomega.Expect(err).To(gomega.HaveOccurred())
+			})
+		})
+	})
+
+	ginkgo.Describe("signing requests", func() {
+		// Computed with the GenSign reference implementation from the custom bot documentation
+		ginkgo.It("should match the reference implementation", func() {
+			gomega.Expect(sign("demo", 1599360473)).To(gomega.Equal("l1N0gAcBjdwBvGm1xMjOF0XSyaLRpR7tuO5dHfhAYc8="))
+		})
+	})
+
+	ginkgo.Describe("sending messages", func() {
+		var requestBody map[string]any
+
+		ginkgo.BeforeEach(func() {
+			httpmock.Activate()
+
+			requestBody = nil
+			httpmock.RegisterResponder("POST", mockWebhookURL, func(req *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(req.Body)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(json.Unmarshal(body, &requestBody)).To(gomega.Succeed())
+
+				return httpmock.NewStringResponse(http.StatusOK, `{"code":0,"msg":"success","data":{}}`), nil
+			})
+
+			serviceURL := testutils.URLMust("lark://" + mockToken + "@open.feishu.cn?mentions=ou_123,all")
+			gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+		})
+		ginkgo.AfterEach(func() {
+			httpmock.DeactivateAndReset()
+		})
+
+		ginkgo.It("should send a text message with the mentions", func() {
+			gomega.Expect(service.Send("Deploy done", &types.Params{"title": "CI"})).To(gomega.Succeed())
+			gomega.Expect(requestBody).To(gomega.Equal(map[string]any{
+				"msg_type": "text",
+				"content": map[string]any{
+					"text": `CI` + "\n" + `Deploy done <at user_id="ou_123"></at> <at user_id="all"></at>`,
+				},
+			}))
+		})
+		ginkgo.It("should send a post message with a paragraph per line", func() {
+			gomega.Expect(service.Send("Deploy done\nAll checks passed", &types.Params{
+				"title": "CI",
+				"type":  "post",
+			})).To(gomega.Succeed())
+			gomega.Expect(requestBody).To(gomega.Equal(map[string]any{
+				"msg_type": "post",
+				"content": map[string]any{
+					"post": map[string]any{
+						"en_us": map[string]any{
+							"title": "CI",
+							"content": []any{
+								[]any{map[string]any{"tag": "text", "text": "Deploy done"}},
+								[]any{
+									map[string]any{"tag": "text", "text": "All checks passed"},
+									map[string]any{"tag": "at", "user_id": "ou_123"},
+									map[string]any{"tag": "at", "user_id": "all"},
+								},
+							},
+						},
+					},
+				},
+			}))
+		})
+		ginkgo.It("should sign the request if a secret is set", func() {
+			gomega.Expect(service.Send("Deploy done", &types.Params{"secret": "s3cr3t"})).To(gomega.Succeed())
+			gomega.Expect(requestBody).To(gomega.HaveKey("timestamp"))
+
+			timestamp, err := strconv.ParseInt(requestBody["timestamp"].(string), 10, 64)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(requestBody["sign"]).To(gomega.Equal(sign("s3cr3t", timestamp)))
+		})
+		ginkgo.It("should not sign the request without a secret", func() {
+			gomega.Expect(service.Send("Deploy done", nil)).To(gomega.Succeed())
+			gomega.Expect(requestBody).NotTo(gomega.HaveKey("sign"))
+		})
+		ginkgo.It("should return the errors reported by the webhook", func() {
+			httpmock.RegisterResponder("POST", mockWebhookURL, httpmock.NewStringResponder(http.StatusOK,
+				`{"code":19021,"msg":"sign match fail or timestamp is not within one hour from current time"}`))
+			gomega.Expect(service.Send("Deploy done", nil)).To(gomega.MatchError(
+				"failed to send lark notification: api response: " +
+					"sign match fail or timestamp is not within one hour from current time (code 19021)"))
+		})
+	})
+
+	ginkgo.Describe("the basic service API", func() {
+		ginkgo.Describe("the service config", func() {
+			ginkgo.It("should implement basic service config API methods correctly", func() {
+				testutils.TestConfigGetInvalidQueryValue(&Config{})
+				testutils.TestConfigSetInvalidQueryValue(&Config{}, "lark://token@host?foo=bar")
+				testutils.TestConfigSetDefaultValues(&Config{})
+				testutils.TestConfigGetEnumsCount(&Config{}, 1)
+				testutils.TestConfigGetFieldsCount(&Config{}, 5)
+			})
+		})
+		ginkgo.Describe("the service instance", func() {
+			ginkgo.It("should implement basic service API methods correctly", func() {
+				serviceURL := testutils.URLMust("lark://" + mockToken + "@host")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				testutils.TestServiceSetInvalidParamValue(service, "foo", "bar")
+			})
+		})
+	})
+
+	ginkgo.It("should return the correct service ID", func() {
+		gomega.Expect(service.GetID()).To(gomega.Equal("lark"))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
 	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
 	"github.com/nicholas-fedor/shoutrrr/pkg/types"
 )
@@ -42,7 +43,8 @@ func (service *Service) doSend(data types.Params) error {
 		msg = wc.String()
 	}
 
-	service.Log(msg)
+	// The log is usually less protected than the notification services, so credentials in URLs are not written to it
+	service.Log(format.RedactURLCredentials(msg))
 
 	return nil
 }
@@ -59,3 +61,8 @@ func (service *Service) Initialize(_ *url.URL, logger types.StdLogger) error {
 func (service *Service) GetID() string {
 	return Scheme
 }
+
+// ExtraParamKeys returns AnyParamKey, since all params are passed to the message template.
+func (*Service) ExtraParamKeys() []string {
+	return []string{types.AnyParamKey}
+}
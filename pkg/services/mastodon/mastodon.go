@@ -0,0 +1,188 @@
+// Package mastodon implements Mastodon as a shoutrrr service, posting statuses using an access token.
+package mastodon
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util"
+)
+
+const (
+	// MaxMediaAttachments is the maximum number of media files that can be attached to a status.
+	MaxMediaAttachments = 4
+
+	// MaxThreadLength is the maximum number of statuses a message is split into, the rest of it is omitted.
+	MaxThreadLength = 10
+
+	// MaxSearchRunes is the maximum number of runes to search for a whitespace to split the statuses on.
+	MaxSearchRunes = 50
+
+	// MediaPollInterval is the time to wait between checking if uploaded media files have been processed.
+	MediaPollInterval = time.Second
+
+	// MediaPollAttempts is the number of times to check if an uploaded media file has been processed.
+	MediaPollAttempts = 30
+)
+
+// threadCounterFormat is appended to the statuses of a thread, with room reserved for the longest counter.
+const (
+	threadCounterFormat  = " (%d/%d)"
+	threadCounterReserve = len(" (10/10)")
+)
+
+// Service posts statuses to a Mastodon instance.
+type Service struct {
+	standard.Standard
+	Config *Config
+	pkr    format.PropKeyResolver
+	sleep  func(time.Duration)
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+	service.sleep = time.Sleep
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier.
+func (service *Service) GetID() string {
+	return Scheme
+}
+
+// Send a notification message as a status, or as a thread of replies if it's longer than the maximum length.
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this message
+	config := *service.Config
+
+	if params != nil {
+		for key := range *params {
+			if strings.EqualFold(key, "media") {
+				return ErrorMediaParam
+			}
+		}
+	}
+
+	if err := service.pkr.UpdateConfigFromParams(&config, params); err != nil {
+		return err
+	}
+
+	media := util.NonEmpty(config.Media)
+	if len(media) > MaxMediaAttachments {
+		return fmt.Errorf("%w: %d, at most %d can be attached", ErrorTooManyMedia, len(media), MaxMediaAttachments)
+	}
+
+	parts, err := service.splitMessage(&config, message)
+	if err != nil {
+		return err
+	}
+
+	client := NewClient(&config)
+
+	mediaIDs, err := service.uploadMedia(client, media)
+	if err != nil {
+		return fmt.Errorf("failed to upload media: %w", err)
+	}
+
+	replyToID := ""
+
+	for i, part := range parts {
+		request := &statusRequest{
+			Status:      part,
+			InReplyToID: replyToID,
+			SpoilerText: config.Title,
+			Sensitive:   config.Title != "",
+			Visibility:  config.Visibility.apiValue(),
+			Language:    config.Language,
+		}
+
+		idempotencyKey := config.IdempotencyKey
+		if i == 0 {
+			request.MediaIDs = mediaIDs
+		} else if idempotencyKey != "" {
+			idempotencyKey = fmt.Sprintf("%s-%d", idempotencyKey, i+1)
+		}
+
+		posted, err := client.PostStatus(request, idempotencyKey)
+		if err != nil {
+			return fmt.Errorf("failed to post status %d of %d: %w", i+1, len(parts), err)
+		}
+
+		service.Logf("Mastodon status posted: %s", posted.URL)
+
+		replyToID = posted.ID
+	}
+
+	return nil
+}
+
+// splitMessage splits the message into parts that fit into a status together with the content warning, adding a
+// counter to each part if there are more than one.
+func (service *Service) splitMessage(config *Config, message string) ([]string, error) {
+	// The content warning counts towards the maximum length of the status
+	maxLength := int(config.MaxLength) - len([]rune(config.Title))
+	if len([]rune(message)) <= maxLength {
+		return []string{message}, nil
+	}
+
+	chunkSize := maxLength - threadCounterReserve
+	if chunkSize < MaxSearchRunes {
+		return nil, fmt.Errorf("the title leaves no room for the message in a status of %d characters", config.MaxLength)
+	}
+
+	items, omitted := util.PartitionMessage(message, types.MessageLimit{
+		ChunkSize:      chunkSize,
+		TotalChunkSize: chunkSize * MaxThreadLength,
+		ChunkCount:     MaxThreadLength + 1,
+	}, MaxSearchRunes)
+
+	if omitted > 0 {
+		service.Logf("Message too long for a thread of %d statuses, %d characters omitted", MaxThreadLength, omitted)
+	}
+
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = item.Text + fmt.Sprintf(threadCounterFormat, i+1, len(items))
+	}
+
+	return parts, nil
+}
+
+// uploadMedia uploads the media files, waiting for them to be processed so they can be attached to a status.
+func (service *Service) uploadMedia(client *Client, paths []string) ([]string, error) {
+	mediaIDs := make([]string, 0, len(paths))
+
+	for _, path := range paths {
+		attachment, processed, err := client.UploadMedia(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		for attempt := 0; !processed; attempt++ {
+			if attempt >= MediaPollAttempts {
+				return nil, fmt.Errorf("%s: %w", path, ErrorMediaProcessing)
+			}
+
+			service.sleep(MediaPollInterval)
+
+			if _, processed, err = client.GetMedia(attachment.ID); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+		}
+
+		mediaIDs = append(mediaIDs, attachment.ID)
+	}
+
+	return mediaIDs, nil
+}
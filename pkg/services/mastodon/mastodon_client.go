@@ -0,0 +1,145 @@
+package mastodon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
+)
+
+// Client for the Mastodon API of an instance.
+type Client struct {
+	config *Config
+}
+
+// NewClient returns a new Client using the instance and access token of the config.
+func NewClient(config *Config) *Client {
+	return &Client{config: config}
+}
+
+// PostStatus posts the status, returning the created status. If an idempotency key is given, retried requests with
+// the same key will not create duplicate statuses.
+func (c *Client) PostStatus(request *statusRequest, idempotencyKey string) (*status, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("error creating payload: %w", err)
+	}
+
+	req, err := c.newRequest(http.MethodPost, "/api/v1/statuses", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", jsonclient.ContentType)
+
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	posted := &status{}
+	if _, err := c.do(req, posted); err != nil {
+		return nil, err
+	}
+
+	return posted, nil
+}
+
+// UploadMedia uploads the media file, returning the attachment and whether it has already been processed.
+func (c *Client) UploadMedia(path string) (*mediaAttachment, bool, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read media file: %w", err)
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("file", filepath.Base(path))
+	if err != nil {
+		return nil, false, fmt.Errorf("error creating payload: %w", err)
+	}
+
+	_, _ = part.Write(file)
+
+	if err := writer.Close(); err != nil {
+		return nil, false, fmt.Errorf("error creating payload: %w", err)
+	}
+
+	req, err := c.newRequest(http.MethodPost, "/api/v2/media", body)
+	if err != nil {
+		return nil, false, err
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	attachment := &mediaAttachment{}
+
+	statusCode, err := c.do(req, attachment)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return attachment, statusCode == http.StatusOK, nil
+}
+
+// GetMedia returns the media attachment with the ID, and whether it has been processed.
+func (c *Client) GetMedia(id string) (*mediaAttachment, bool, error) {
+	req, err := c.newRequest(http.MethodGet, "/api/v1/media/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	attachment := &mediaAttachment{}
+
+	statusCode, err := c.do(req, attachment)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return attachment, statusCode == http.StatusOK, nil
+}
+
+func (c *Client) newRequest(method string, endpoint string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, c.config.GetAPIURL(endpoint), body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.config.Token)
+
+	return req, nil
+}
+
+// do sends the request and unmarshals the response body into response, returning the response status code.
+func (c *Client) do(req *http.Request, response any) (int, error) {
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error sending request: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	resBytes, _ := io.ReadAll(res.Body)
+
+	if res.StatusCode >= jsonclient.HTTPClientErrorThreshold {
+		errResponse := errorResponse{}
+		if err := json.Unmarshal(resBytes, &errResponse); err == nil && errResponse.Error != "" {
+			return res.StatusCode, &apiError{StatusCode: res.StatusCode, Message: errResponse.Error}
+		}
+
+		return res.StatusCode, fmt.Errorf("server returned %v", res.Status)
+	}
+
+	if err := json.Unmarshal(resBytes, response); err != nil {
+		return res.StatusCode, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return res.StatusCode, nil
+}
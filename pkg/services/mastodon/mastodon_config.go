@@ -0,0 +1,94 @@
+package mastodon
+
+import (
+	"net/url"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Config for the Mastodon service.
+type Config struct {
+	Token          string     `desc:"Access token of the application, with the write:statuses (and write:media) scope" required:""                                                                            secret:""           url:"user"`
+	Host           string     `desc:"Hostname (and port) of the Mastodon instance"                                     required:""                                                                            url:"host,port"`
+	Visibility     visibility `default:"Public"                                                                        desc:"Who can see the statuses"                                                        key:"visibility"`
+	Title          string     `default:""                                                                              desc:"Content warning, shown instead of the status until expanded"                     key:"title,spoiler"`
+	Language       string     `desc:"ISO 639 language code of the statuses"                                            key:"language,lang"                                                                    optional:""`
+	IdempotencyKey string     `desc:"Prevents the same notification from being posted twice"                           key:"idempotencykey"                                                                   optional:""`
+	Media          []string   `desc:"Paths of the image, video or audio files to attach to the first status"           key:"media"                                                                            optional:""         sep:"|"`
+	MaxLength      uint       `default:"500"                                                                           desc:"Maximum number of characters per status, longer messages are posted as a thread" key:"maxlength"     max:"100000" min:"100"`
+	DisableTLS     bool       `default:"No"                                                                            desc:"Use plain HTTP to connect to the instance"                                       key:"disabletls"`
+}
+
+// Enums returns the fields that should use a corresponding EnumFormatter to Print/Parse their values.
+func (config *Config) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"Visibility": Visibility.Enum,
+	}
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+// GetAPIURL returns the URL of the API endpoint of the instance.
+func (config *Config) GetAPIURL(endpoint string) string {
+	apiURL := url.URL{
+		Scheme: "https",
+		Host:   config.Host,
+		Path:   endpoint,
+	}
+
+	if config.DisableTLS {
+		apiURL.Scheme = "http"
+	}
+
+	return apiURL.String()
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	return &url.URL{
+		User:     url.User(config.Token),
+		Host:     config.Host,
+		Scheme:   Scheme,
+		RawQuery: format.BuildQuery(resolver),
+	}
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	config.Token = url.User.Username()
+	config.Host = url.Host
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	if url.String() != "mastodon://dummy@dummy.com" {
+		if config.Token == "" {
+			return ErrorMissingToken
+		}
+
+		if config.Host == "" {
+			return ErrorMissingHost
+		}
+	}
+
+	return nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "mastodon"
+)
@@ -0,0 +1,21 @@
+package mastodon
+
+import "errors"
+
+var (
+	// ErrorMissingToken is returned if the access token is missing from the service URL.
+	ErrorMissingToken = errors.New("missing access token")
+
+	// ErrorMissingHost is returned if the instance host is missing from the service URL.
+	ErrorMissingHost = errors.New("missing instance host")
+
+	// ErrorTooManyMedia is returned if more media files are specified than can be attached to a status.
+	ErrorTooManyMedia = errors.New("too many media files")
+
+	// ErrorMediaParam is returned if the media files are specified using the params, since they are read from the
+	// local file system, and may only be set in the service URL.
+	ErrorMediaParam = errors.New("media files can only be set in the service URL, not using params")
+
+	// ErrorMediaProcessing is returned if an uploaded media file has not been processed in time.
+	ErrorMediaProcessing = errors.New("media file was not processed in time")
+)
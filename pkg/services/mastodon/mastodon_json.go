@@ -0,0 +1,46 @@
+package mastodon
+
+import "fmt"
+
+// statusRequest is the request body used to post a status.
+//
+// See: https://docs.joinmastodon.org/methods/statuses/#create
+type statusRequest struct {
+	Status      string   `json:"status"`
+	InReplyToID string   `json:"in_reply_to_id,omitempty"`
+	MediaIDs    []string `json:"media_ids,omitempty"`
+	Sensitive   bool     `json:"sensitive,omitempty"`
+	SpoilerText string   `json:"spoiler_text,omitempty"`
+	Visibility  string   `json:"visibility"`
+	Language    string   `json:"language,omitempty"`
+}
+
+// status is the part of a posted status that is used by the service.
+type status struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+// mediaAttachment is the part of an uploaded media attachment that is used by the service.
+// The URL is null until the media has been processed.
+//
+// See: https://docs.joinmastodon.org/methods/media/#v2
+type mediaAttachment struct {
+	ID  string  `json:"id"`
+	URL *string `json:"url"`
+}
+
+// errorResponse is the body of an API error response.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// apiError is returned for error responses of the API.
+type apiError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("api response: %v (HTTP %v)", e.Message, e.StatusCode)
+}
@@ -0,0 +1,312 @@
+package mastodon
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/internal/testutils"
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+const mockToken = "accesstoken"
+
+func TestMastodon(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Shoutrrr Mastodon Suite")
+}
+
+var (
+	service *Service
+	logger  *log.Logger = testutils.TestLogger()
+)
+
+// mockRequest is a status request received by the mock server.
+type mockRequest struct {
+	statusRequest
+	IdempotencyKey string
+}
+
+// mockServer is a minimal Mastodon API, recording the posted statuses and uploaded media.
+type mockServer struct {
+	*httptest.Server
+	mutex      sync.Mutex
+	statuses   []mockRequest
+	uploads    []string
+	mediaPolls int
+}
+
+func newMockServer() *mockServer {
+	server := &mockServer{}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /api/v1/statuses", func(res http.ResponseWriter, req *http.Request) {
+		defer ginkgo.GinkgoRecover()
+
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+
+		request := mockRequest{IdempotencyKey: req.Header.Get("Idempotency-Key")}
+		gomega.Expect(json.NewDecoder(req.Body).Decode(&request.statusRequest)).To(gomega.Succeed())
+
+		if len([]rune(request.Status))+len([]rune(request.SpoilerText)) > 500 {
+			res.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = res.Write([]byte(`{"error": "Validation failed: Text character limit of 500 exceeded"}`))
+
+			return
+		}
+
+		server.statuses = append(server.statuses, request)
+		id := len(server.statuses)
+		_, _ = fmt.Fprintf(res, `{"id": "%d", "url": "https://mastodon.example/@bot/%d"}`, id, id)
+	})
+	mux.HandleFunc("POST /api/v2/media", func(res http.ResponseWriter, req *http.Request) {
+		defer ginkgo.GinkgoRecover()
+
+		file, header, err := req.FormFile("file")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		content, _ := io.ReadAll(file)
+
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+
+		server.uploads = append(server.uploads, header.Filename+":"+string(content))
+
+		// Video files are processed asynchronously
+		if strings.HasSuffix(header.Filename, ".mp4") {
+			res.WriteHeader(http.StatusAccepted)
+			_, _ = fmt.Fprintf(res, `{"id": "media%d", "url": null}`, len(server.uploads))
+
+			return
+		}
+
+		_, _ = fmt.Fprintf(res, `{"id": "media%d", "url": "https://mastodon.example/media"}`, len(server.uploads))
+	})
+	mux.HandleFunc("GET /api/v1/media/{id}", func(res http.ResponseWriter, req *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+
+		server.mediaPolls++
+		if server.mediaPolls < 3 {
+			res.WriteHeader(http.StatusPartialContent)
+			_, _ = fmt.Fprintf(res, `{"id": "%s", "url": null}`, req.PathValue("id"))
+
+			return
+		}
+
+		_, _ = fmt.Fprintf(res, `{"id": "%s", "url": "https://mastodon.example/media"}`, req.PathValue("id"))
+	})
+
+	server.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer ginkgo.GinkgoRecover()
+
+		gomega.Expect(req.Header.Get("Authorization")).To(gomega.Equal("Bearer " + mockToken))
+		mux.ServeHTTP(res, req)
+	}))
+
+	return server
+}
+
+func (server *mockServer) serviceURL(query string) *url.URL {
+	return testutils.URLMust("mastodon://" + mockToken + "@" + strings.TrimPrefix(server.URL, "http://") +
+		"?disabletls=yes" + query)
+}
+
+var _ = ginkgo.Describe("the Mastodon service", func() {
+	ginkgo.BeforeEach(func() {
+		service = &Service{}
+	})
+
+	ginkgo.Describe("the config", func() {
+		ginkgo.When("only required fields are set", func() {
+			ginkgo.It("should set the optional fields to the defaults", func() {
+				serviceURL := testutils.URLMust("mastodon://" + mockToken + "@mastodon.example")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				gomega.Expect(*service.Config).To(gomega.Equal(Config{
+					Token:      mockToken,
+					Host:       "mastodon.example",
+					Visibility: VisibilityPublic,
+					Media:      []string{""},
+					MaxLength:  500,
+				}))
+				gomega.Expect(service.Config.GetAPIURL("/api/v1/statuses")).
+					To(gomega.Equal("https://mastodon.example/api/v1/statuses"))
+			})
+		})
+		ginkgo.When("the token is missing", func() {
+			ginkgo.It("should return an error", func() {
+				serviceURL := testutils.URLMust("mastodon://mastodon.example")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.MatchError(ErrorMissingToken))
+			})
+		})
+		ginkgo.When("parsing the configuration URL", func() {
+			ginkgo.It("should be identical after de-/serialization", func() {
+				testURL := "mastodon://" + mockToken + "@mastodon.example:8443" +
+					"?disabletls=Yes&idempotencykey=release-1.2.0&language=en&maxlength=1000&media=%2Ftmp%2Fa.png%7C%2Ftmp%2Fb.png" +
+					"&title=Release&visibility=Unlisted"
+				config := &Config{}
+				pkr := format.NewPropKeyResolver(config)
+				gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+				gomega.Expect(config.setURL(&pkr, testutils.URLMust(testURL))).To(gomega.Succeed(), "verifying")
+				gomega.Expect(config.GetURL().String()).To(gomega.Equal(testURL))
+			})
+		})
+	})
+
+	ginkgo.Describe("splitting messages", func() {
+		ginkgo.BeforeEach(func() {
+			gomega.Expect(service.Initialize(testutils.URLMust("mastodon://token@mastodon.example"), logger)).
+				To(gomega.Succeed())
+		})
+
+		ginkgo.It("should not split messages that fit into a status", func() {
+			message := strings.Repeat("a", 500)
+			gomega.Expect(service.splitMessage(service.Config, message)).To(gomega.Equal([]string{message}))
+		})
+		ginkgo.It("should make room for the content warning and the thread counter", func() {
+			config := *service.Config
+			config.Title = "Incident"
+
+			parts, err := service.splitMessage(&config, strings.Repeat("All systems degraded. ", 40))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(parts).To(gomega.HaveLen(2))
+			gomega.Expect(parts[0]).To(gomega.HaveSuffix("degraded. (1/2)"))
+			gomega.Expect(parts[1]).To(gomega.HaveSuffix(" (2/2)"))
+
+			for _, part := range parts {
+				gomega.Expect(len(part) + len(config.Title)).To(gomega.BeNumerically("<=", 500))
+			}
+		})
+		ginkgo.It("should return an error if the title leaves no room for the message", func() {
+			config := *service.Config
+			config.Title = strings.Repeat("a", 480)
+
+			_, err := service.splitMessage(&config, strings.Repeat("b", 100))
+			gomega.Expect(err).To(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Describe("posting statuses", func() {
+		var (
+			server *mockServer
+			delays []time.Duration
+		)
+
+		ginkgo.BeforeEach(func() {
+			server = newMockServer()
+			delays = nil
+		})
+		ginkgo.AfterEach(func() {
+			server.Close()
+		})
+
+		initService := func(query string) {
+			gomega.Expect(service.Initialize(server.serviceURL(query), logger)).To(gomega.Succeed())
+			service.sleep = func(delay time.Duration) {
+				delays = append(delays, delay)
+			}
+		}
+
+		ginkgo.It("should post the message using the config", func() {
+			initService("&visibility=unlisted&lang=en&idempotencykey=release-1.2.0")
+			gomega.Expect(service.Send("Version 1.2.0 is out!", &types.Params{"title": "Release"})).To(gomega.Succeed())
+			gomega.Expect(server.statuses).To(gomega.Equal([]mockRequest{{
+				statusRequest: statusRequest{
+					Status:      "Version 1.2.0 is out!",
+					Sensitive:   true,
+					SpoilerText: "Release",
+					Visibility:  "unlisted",
+					Language:    "en",
+				},
+				IdempotencyKey: "release-1.2.0",
+			}}))
+		})
+		ginkgo.It("should post long messages as a thread of replies", func() {
+			initService("&idempotencykey=incident-42")
+			gomega.Expect(service.Send(strings.Repeat("All systems degraded. ", 50), nil)).To(gomega.Succeed())
+			gomega.Expect(server.statuses).To(gomega.HaveLen(3))
+			gomega.Expect(server.statuses[0].InReplyToID).To(gomega.BeEmpty())
+			gomega.Expect(server.statuses[1].InReplyToID).To(gomega.Equal("1"))
+			gomega.Expect(server.statuses[2].InReplyToID).To(gomega.Equal("2"))
+			gomega.Expect(server.statuses[2].Status).To(gomega.HaveSuffix(" (3/3)"))
+			gomega.Expect([]string{
+				server.statuses[0].IdempotencyKey,
+				server.statuses[1].IdempotencyKey,
+				server.statuses[2].IdempotencyKey,
+			}).To(gomega.Equal([]string{"incident-42", "incident-42-2", "incident-42-3"}))
+		})
+		ginkgo.It("should upload the media and attach it to the first status", func() {
+			dir := ginkgo.GinkgoT().TempDir()
+			image := filepath.Join(dir, "chart.png")
+			video := filepath.Join(dir, "demo.mp4")
+			gomega.Expect(os.WriteFile(image, []byte("png"), 0o600)).To(gomega.Succeed())
+			gomega.Expect(os.WriteFile(video, []byte("mp4"), 0o600)).To(gomega.Succeed())
+
+			initService("&media=" + url.QueryEscape(image+"|"+video))
+			gomega.Expect(service.Send("Version 1.2.0 is out!", nil)).To(gomega.Succeed())
+			gomega.Expect(server.uploads).To(gomega.Equal([]string{"chart.png:png", "demo.mp4:mp4"}))
+			gomega.Expect(delays).To(gomega.HaveLen(3))
+			gomega.Expect(server.statuses).To(gomega.HaveLen(1))
+			gomega.Expect(server.statuses[0].MediaIDs).To(gomega.Equal([]string{"media1", "media2"}))
+		})
+		ginkgo.It("should return an error if a media file cannot be read", func() {
+			initService("&media=/nonexistent/chart.png")
+			gomega.Expect(service.Send("Version 1.2.0 is out!", nil)).To(gomega.HaveOccurred())
+			gomega.Expect(server.statuses).To(gomega.BeEmpty())
+		})
+		ginkgo.It("should not read media files specified using the params", func() {
+			initService("")
+			gomega.Expect(service.Send("Version 1.2.0 is out!", &types.Params{"Media": "/etc/passwd"})).
+				To(gomega.MatchError(ErrorMediaParam))
+			gomega.Expect(server.uploads).To(gomega.BeEmpty())
+			gomega.Expect(server.statuses).To(gomega.BeEmpty())
+		})
+		ginkgo.It("should return an error if too many media files are specified", func() {
+			initService("&media=a|b|c|d|e")
+			gomega.Expect(service.Send("Version 1.2.0 is out!", nil)).To(gomega.MatchError(ErrorTooManyMedia))
+		})
+		ginkgo.It("should return the errors reported by the API", func() {
+			initService("&maxlength=1000")
+			gomega.Expect(service.Send(strings.Repeat("a", 600), nil)).To(gomega.MatchError(
+				"failed to post status 1 of 1: api response: Validation failed: Text character limit of 500 exceeded (HTTP 422)"))
+		})
+	})
+
+	ginkgo.Describe("the basic service API", func() {
+		ginkgo.Describe("the service config", func() {
+			ginkgo.It("should implement basic service config API methods correctly", func() {
+				testutils.TestConfigGetInvalidQueryValue(&Config{})
+				testutils.TestConfigSetInvalidQueryValue(&Config{}, "mastodon://token@host?foo=bar")
+				testutils.TestConfigSetDefaultValues(&Config{})
+				testutils.TestConfigGetEnumsCount(&Config{}, 1)
+				testutils.TestConfigGetFieldsCount(&Config{}, 9)
+			})
+		})
+		ginkgo.Describe("the service instance", func() {
+			ginkgo.It("should implement basic service API methods correctly", func() {
+				serviceURL := testutils.URLMust("mastodon://" + mockToken + "@host")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				testutils.TestServiceSetInvalidParamValue(service, "foo", "bar")
+			})
+		})
+	})
+
+	ginkgo.It("should return the correct service ID", func() {
+		gomega.Expect(service.GetID()).To(gomega.Equal("mastodon"))
+	})
+})
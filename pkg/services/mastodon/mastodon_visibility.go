@@ -0,0 +1,52 @@
+package mastodon
+
+import (
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Visibility levels as constants.
+const (
+	VisibilityPublic   visibility = 0
+	VisibilityUnlisted visibility = 1
+	VisibilityPrivate  visibility = 2
+	VisibilityDirect   visibility = 3
+)
+
+type visibility int
+
+type visibilityVals struct {
+	Public   visibility
+	Unlisted visibility
+	Private  visibility
+	Direct   visibility
+	Enum     types.EnumFormatter
+}
+
+// Visibility defines who can see the posted statuses.
+var Visibility = &visibilityVals{
+	Public:   VisibilityPublic,
+	Unlisted: VisibilityUnlisted,
+	Private:  VisibilityPrivate,
+	Direct:   VisibilityDirect,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"Public",
+			"Unlisted",
+			"Private",
+			"Direct",
+		}, map[string]int{
+			"followers": int(VisibilityPrivate),
+		}),
+}
+
+func (v visibility) String() string {
+	return Visibility.Enum.Print(int(v))
+}
+
+// apiValue returns the value used for the visibility field of the status request.
+func (v visibility) apiValue() string {
+	return strings.ToLower(v.String())
+}
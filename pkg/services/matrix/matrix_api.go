@@ -11,6 +11,8 @@ const (
 	apiRoomJoin    = "/_matrix/client/r0/join/%s"
 	apiSendMessage = "/_matrix/client/r0/rooms/%s/send/m.room.message"
 	apiJoinedRooms = "/_matrix/client/r0/joined_rooms"
+	apiWhoAmI      = "/_matrix/client/r0/account/whoami"
+	apiRoomAlias   = "/_matrix/client/r0/directory/room/%s"
 
 	contentType = "application/json"
 
@@ -52,6 +54,10 @@ type apiResJoinedRooms struct {
 	Rooms []string `json:"joined_rooms"`
 }
 
+type apiResWhoAmI struct {
+	UserID string `json:"user_id"`
+}
+
 type apiResEvent struct {
 	EventID string `json:"event_id"`
 }
@@ -31,11 +31,13 @@ type client struct {
 	apiURL      url.URL
 	accessToken string
 	logger      types.StdLogger
+	httpClient  *http.Client
 }
 
 func newClient(host string, disableTLS bool, logger types.StdLogger) (c *client) {
 	c = &client{
-		logger: logger,
+		logger:     logger,
+		httpClient: http.DefaultClient,
 		apiURL: url.URL{
 			Host:   host,
 			Scheme: "https",
@@ -183,7 +185,7 @@ func (c *client) apiGet(path string, response interface{}) error {
 
 	var res *http.Response
 
-	res, err = http.Get(c.apiURL.String())
+	res, err = c.httpClient.Get(c.apiURL.String())
 	if err != nil {
 		return err
 	}
@@ -225,7 +227,7 @@ func (c *client) apiPost(path string, request interface{}, response interface{})
 
 	var res *http.Response
 
-	res, err = http.Post(c.apiURL.String(), contentType, bytes.NewReader(body))
+	res, err = c.httpClient.Post(c.apiURL.String(), contentType, bytes.NewReader(body))
 	if err != nil {
 		return err
 	}
@@ -269,3 +271,21 @@ func (c *client) getJoinedRooms() ([]string, error) {
 
 	return response.Rooms, nil
 }
+
+func (c *client) whoAmI() (string, error) {
+	response := apiResWhoAmI{}
+	if err := c.apiGet(apiWhoAmI, &response); err != nil {
+		return "", err
+	}
+
+	return response.UserID, nil
+}
+
+func (c *client) resolveRoomAlias(alias string) (string, error) {
+	response := apiResRoom{}
+	if err := c.apiGet(fmt.Sprintf(apiRoomAlias, alias), &response); err != nil {
+		return "", err
+	}
+
+	return response.RoomID, nil
+}
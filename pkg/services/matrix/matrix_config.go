@@ -13,7 +13,7 @@ type Config struct {
 	standard.EnumlessConfig
 
 	User       string   `desc:"Username or empty when using access token" optional:""      url:"user"`
-	Password   string   `desc:"Password or access token"                  url:"password"`
+	Password   string   `desc:"Password or access token"                  secret:""        url:"password"`
 	DisableTLS bool     `default:"No"                                     key:"disableTLS"`
 	Host       string   `url:"host"`
 	Rooms      []string `desc:"Room aliases, or with ! prefix, room IDs"  key:"rooms,room" optional:""`
@@ -57,8 +57,8 @@ func (c *Config) setURL(resolver types.ConfigQueryResolver, configURL *url.URL)
 	}
 
 	for r, room := range c.Rooms {
-		// If room does not begin with a '#' let's prepend it
-		if room[0] != '#' && room[0] != '!' {
+		// If room does not begin with a '#' let's prepend it, empty rooms are left as is since they are not set
+		if room != "" && room[0] != '#' && room[0] != '!' {
 			c.Rooms[r] = "#" + room
 		}
 	}
@@ -0,0 +1,61 @@
+package matrix
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// verifyClient is the HTTP client used for verifying the configuration, so that unresponsive homeservers
+// cannot block the verification.
+var verifyClient = &http.Client{Timeout: types.VerifyTimeout}
+
+// Verify checks that the access token is accepted by the homeserver and that the configured rooms
+// exist, without sending any messages.
+func (s *Service) Verify() error {
+	if s.client == nil {
+		return fmt.Errorf("client not initialized; cannot verify configuration")
+	}
+
+	// A copy of the client is used, so that sending is not affected by the verification timeout
+	client := *s.client
+	client.httpClient = verifyClient
+
+	userID, err := client.whoAmI()
+	if err != nil {
+		return fmt.Errorf("access token was not accepted: %w", err)
+	}
+
+	s.Logf("Authenticated as %v", userID)
+
+	joinedRooms, err := client.getJoinedRooms()
+	if err != nil {
+		return fmt.Errorf("failed to get joined rooms: %w", err)
+	}
+
+	if len(s.Config.Rooms) < minSliceLength {
+		if len(joinedRooms) < minSliceLength {
+			return fmt.Errorf("no rooms specified and the user %v has not joined any rooms", userID)
+		}
+
+		return nil
+	}
+
+	for _, room := range s.Config.Rooms {
+		roomID := room
+
+		if room[0] == '#' {
+			if roomID, err = client.resolveRoomAlias(room); err != nil {
+				return fmt.Errorf("unknown room %q: %w", room, err)
+			}
+		}
+
+		if !slices.Contains(joinedRooms, roomID) {
+			s.Logf("Room %v has not been joined yet, and will be joined when sending", room)
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/nicholas-fedor/shoutrrr/pkg/format"
 	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
@@ -60,12 +62,34 @@ func (service *Service) GetID() string {
 	return Scheme
 }
 
+// ExtraParamKeys returns the keys of the params that override the username and channel of the payload.
+func (*Service) ExtraParamKeys() []string {
+	return payloadParamKeys
+}
+
+// GetConfigURLFromCustom creates a regular service URL from a native webhook URL,
+// e.g. mattermost+https://mattermost.example.com/hooks/TOKEN.
+func (*Service) GetConfigURLFromCustom(customURL *url.URL) (*url.URL, error) {
+	token, found := strings.CutPrefix(customURL.Path, "/hooks/")
+	if !found || token == "" || strings.Contains(token, "/") {
+		return nil, fmt.Errorf("invalid webhook URL path %q, expected /hooks/TOKEN", customURL.Path)
+	}
+
+	config := &Config{
+		Host:       customURL.Host,
+		Token:      token,
+		DisableTLS: strings.HasSuffix(customURL.Scheme, "+http"),
+	}
+
+	return config.GetURL(), nil
+}
+
 // Send a notification message to Mattermost.
 func (service *Service) Send(message string, params *types.Params) error {
 	config := service.Config
 	apiURL := buildURL(config)
 
-	if err := service.pkr.UpdateConfigFromParams(config, params); err != nil {
+	if err := service.pkr.UpdateConfigFromParams(config, getPropParams(params)); err != nil {
 		return err
 	}
 
@@ -92,3 +116,20 @@ func buildURL(config *Config) string {
 
 	return fmt.Sprintf("%s://%s/hooks/%s", scheme, config.Host, config.Token)
 }
+
+// getPropParams returns the params without the ones that are only used for the payload.
+func getPropParams(params *types.Params) *types.Params {
+	if params == nil {
+		return nil
+	}
+
+	props := types.Params{}
+
+	for key, value := range *params {
+		if !slices.Contains(payloadParamKeys, key) {
+			props[key] = value
+		}
+	}
+
+	return &props
+}
@@ -18,7 +18,7 @@ type Config struct {
 	Title      string `default:""                      desc:"Notification title, optionally set by the sender (not used)"       key:"title"`
 	Channel    string `desc:"Override webhook channel" optional:""                                                              url:"path2"`
 	Host       string `desc:"Mattermost server host"   url:"host,port"`
-	Token      string `desc:"Webhook token"            url:"path1"`
+	Token      string `desc:"Webhook token"            secret:""                                                                url:"path1"`
 	DisableTLS bool   `default:"No"                    key:"disabletls"`
 }
 
@@ -46,6 +46,13 @@ func (config *Config) GetURL() *url.URL {
 	}
 }
 
+// WebhookURL returns the native Mattermost webhook URL for the config.
+func (config *Config) WebhookURL() *url.URL {
+	webhookURL, _ := url.Parse(buildURL(config))
+
+	return webhookURL
+}
+
 // SetURL updates a ServiceConfig from a URL representation of it's field values.
 func (config *Config) SetURL(url *url.URL) error {
 	resolver := format.NewPropKeyResolver(config)
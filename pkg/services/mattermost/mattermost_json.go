@@ -32,6 +32,9 @@ func (j *JSON) SetIcon(icon string) {
 	}
 }
 
+// payloadParamKeys are the keys of the params that override the username and channel of the payload.
+var payloadParamKeys = []string{"username", "channel"}
+
 // CreateJSONPayload for usage with the mattermost service.
 func CreateJSONPayload(config *Config, message string, params *types.Params) ([]byte, error) {
 	payload := JSON{
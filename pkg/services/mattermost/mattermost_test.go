@@ -2,6 +2,8 @@ package mattermost
 
 import (
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"os"
 	"testing"
@@ -172,6 +174,22 @@ var _ = ginkgo.Describe("the mattermost service", func() {
 				err = service.Send("Message", nil)
 				gomega.Expect(err).NotTo(gomega.HaveOccurred())
 			})
+			ginkgo.It("should override the channel and username using params", func() {
+				service := Service{}
+				err = service.Initialize(testutils.URLMust("mattermost://mattermost.host/token"), nil)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				httpmock.ActivateNonDefault(service.httpClient)
+				httpmock.RegisterResponder("POST", "https://mattermost.host/hooks/token",
+					func(req *http.Request) (*http.Response, error) {
+						body, _ := io.ReadAll(req.Body)
+						gomega.Expect(string(body)).To(gomega.Equal(`{"text":"Message","username":"bot","channel":"alerts"}`))
+
+						return httpmock.NewStringResponse(200, ""), nil
+					})
+				err = service.Send("Message", &types.Params{"channel": "alerts", "username": "bot"})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(service.Config.Channel).To(gomega.BeEmpty())
+			})
 			ginkgo.It("should return an error if the server rejects the payload", func() {
 				config := Config{
 					Host:  "mattermost.host",
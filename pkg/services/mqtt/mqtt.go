@@ -0,0 +1,159 @@
+// Package mqtt implements publishing to an MQTT broker as a shoutrrr service.
+package mqtt
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// disconnectQuiesce is the time in milliseconds that the client waits for pending work before disconnecting.
+const disconnectQuiesce = 250
+
+// Service providing MQTT as a notification service.
+type Service struct {
+	standard.Standard
+	Config    *Config
+	pkr       format.PropKeyResolver
+	tlsConfig *tls.Config
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+	service.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier.
+func (service *Service) GetID() string {
+	return Scheme
+}
+
+// ExtraParamKeys returns AnyParamKey, since params that are not config props are added to the JSON envelope.
+func (*Service) ExtraParamKeys() []string {
+	return []string{types.AnyParamKey}
+}
+
+// Send publishes the message to the topic, connecting to the broker for each message.
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this message
+	config := *service.Config
+
+	props, extraParams := service.splitParams(params)
+
+	if err := service.pkr.UpdateConfigFromParams(&config, &props); err != nil {
+		return err
+	}
+
+	payload, err := createPayload(&config, message, extraParams)
+	if err != nil {
+		return err
+	}
+
+	client := paho.NewClient(service.getClientOptions(&config))
+
+	connectToken := client.Connect()
+	if !connectToken.WaitTimeout(config.Timeout) {
+		return fmt.Errorf("timed out connecting to MQTT broker %s", config.GetBrokerURL())
+	}
+
+	if err := connectToken.Error(); err != nil {
+		return fmt.Errorf("failed to connect to MQTT broker %s: %w", config.GetBrokerURL(), err)
+	}
+
+	defer client.Disconnect(disconnectQuiesce)
+
+	publishToken := client.Publish(config.Topic, config.QoS, config.Retain, payload)
+	if !publishToken.WaitTimeout(config.Timeout) {
+		return fmt.Errorf("timed out publishing to MQTT topic %q", config.Topic)
+	}
+
+	if err := publishToken.Error(); err != nil {
+		return fmt.Errorf("failed to publish to MQTT topic %q: %w", config.Topic, err)
+	}
+
+	service.Logf("Published %d bytes to MQTT topic %q", len(payload), config.Topic)
+
+	return nil
+}
+
+func (service *Service) getClientOptions(config *Config) *paho.ClientOptions {
+	options := paho.NewClientOptions().
+		AddBroker(config.GetBrokerURL()).
+		SetClientID(config.ClientID).
+		SetUsername(config.Username).
+		SetPassword(config.Password).
+		SetCleanSession(true).
+		SetAutoReconnect(false).
+		SetConnectRetry(false).
+		SetConnectTimeout(config.Timeout).
+		SetWriteTimeout(config.Timeout)
+
+	if config.UseTLS() {
+		options.SetTLSConfig(service.tlsConfig)
+	}
+
+	return options
+}
+
+// splitParams splits the params into the ones that set config props, and the other ones, which are only
+// included in the JSON envelope.
+func (service *Service) splitParams(params *types.Params) (types.Params, map[string]string) {
+	props := types.Params{}
+	extraParams := map[string]string{}
+
+	if params == nil {
+		return props, extraParams
+	}
+
+	propKeys := service.pkr.QueryFields()
+
+	for key, value := range *params {
+		switch {
+		case slices.Contains(propKeys, strings.ToLower(key)):
+			props[key] = value
+		case key != types.MessageKey:
+			extraParams[key] = value
+		}
+	}
+
+	return props, extraParams
+}
+
+// createPayload returns the message as is, or wrapped in a JSON envelope with the title and the extra params.
+func createPayload(config *Config, message string, extraParams map[string]string) ([]byte, error) {
+	if config.Format == PayloadFormatRaw {
+		return []byte(message), nil
+	}
+
+	envelope := Envelope{
+		Title:   config.Title,
+		Message: message,
+	}
+
+	if len(extraParams) > 0 {
+		envelope.Params = extraParams
+	}
+
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JSON envelope: %w", err)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,157 @@
+package mqtt
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Default broker ports.
+const (
+	DefaultPort    = 1883
+	DefaultTLSPort = 8883
+)
+
+// Config for use within the MQTT service.
+type Config struct {
+	Host     string        `desc:"MQTT broker hostname"                                          required:""                                                                       url:"host"`
+	Port     uint16        `desc:"MQTT broker port, 1883 for mqtt and 8883 for mqtts if not set" optional:""                                                                       url:"port"`
+	Username string        `desc:"Username for authentication"                                   optional:""                                                                       url:"user"`
+	Password string        `desc:"Password for authentication"                                   optional:""                                                                       secret:""      url:"password"`
+	Topic    string        `desc:"The topic to publish the messages to"                          required:""                                                                       url:"path"`
+	ClientID string        `default:""                                                           desc:"The client identifier, assigned by the broker if empty"                     key:"clientid"`
+	QoS      uint8         `default:"0"                                                          desc:"Quality of service level of the published messages"                         key:"qos"      max:"2"        min:"0"`
+	Retain   bool          `default:"No"                                                         desc:"Whether the broker should retain the last message for new subscribers"      key:"retain"`
+	Format   payloadFormat `default:"Raw"                                                        desc:"Publish the message as is, or as a JSON envelope with the title and params" key:"format"`
+	Title    string        `default:""                                                           desc:"Notification title, included in the JSON envelope"                          key:"title"`
+	Timeout  time.Duration `default:"10s"                                                        desc:"Timeout for connecting to the broker and publishing the message"            key:"timeout"`
+
+	// useTLS is set by the scheme of the service URL, mqtts or mqtt
+	useTLS bool
+}
+
+// Enums returns the fields that should use a corresponding EnumFormatter to Print/Parse their values.
+func (config *Config) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"Format": PayloadFormat.Enum,
+	}
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+// UseTLS returns whether the connection to the broker uses TLS.
+func (config *Config) UseTLS() bool {
+	return config.useTLS
+}
+
+// GetBrokerURL returns the URL used to connect to the broker.
+func (config *Config) GetBrokerURL() string {
+	scheme := "tcp"
+	port := config.Port
+
+	if config.useTLS {
+		scheme = "ssl"
+	}
+
+	if port == 0 {
+		port = config.defaultPort()
+	}
+
+	return scheme + "://" + net.JoinHostPort(config.Host, strconv.Itoa(int(port)))
+}
+
+func (config *Config) defaultPort() uint16 {
+	if config.useTLS {
+		return DefaultTLSPort
+	}
+
+	return DefaultPort
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	scheme := Scheme
+	if config.useTLS {
+		scheme = SchemeTLS
+	}
+
+	host := config.Host
+	if config.Port > 0 && config.Port != config.defaultPort() {
+		host = net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port)))
+	}
+
+	serviceURL := &url.URL{
+		Host:     host,
+		Path:     "/" + config.Topic,
+		Scheme:   scheme,
+		RawQuery: format.BuildQuery(resolver),
+	}
+
+	if config.Username != "" || config.Password != "" {
+		serviceURL.User = url.UserPassword(config.Username, config.Password)
+	}
+
+	return serviceURL
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	config.useTLS = url.Scheme == SchemeTLS
+	config.Host = url.Hostname()
+	config.Username = url.User.Username()
+	config.Password, _ = url.User.Password()
+	config.Topic = strings.TrimPrefix(url.Path, "/")
+	config.Port = 0
+
+	if url.Port() != "" {
+		port, err := strconv.ParseUint(url.Port(), 10, 16)
+		if err != nil {
+			return errors.New("invalid port")
+		}
+
+		config.Port = uint16(port)
+	}
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	// The dummy URL is used for both schemes, so the scheme is not compared
+	if strings.TrimPrefix(url.String(), url.Scheme) != "://dummy@dummy.com" {
+		if config.Topic == "" {
+			return errors.New("topic missing from config URL path")
+		}
+
+		// Wildcards can only be used when subscribing
+		if strings.ContainsAny(config.Topic, "+#") {
+			return errors.New("the topic cannot contain the wildcards + and #")
+		}
+	}
+
+	return nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "mqtt"
+	// SchemeTLS is the scheme used for connecting to the broker using TLS.
+	SchemeTLS = "mqtts"
+)
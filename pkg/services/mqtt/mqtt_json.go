@@ -0,0 +1,8 @@
+package mqtt
+
+// Envelope is the JSON payload published when using the JSON format.
+type Envelope struct {
+	Title   string            `json:"title,omitempty"`
+	Message string            `json:"message"`
+	Params  map[string]string `json:"params,omitempty"`
+}
@@ -0,0 +1,35 @@
+package mqtt
+
+import (
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Payload formats as constants.
+const (
+	PayloadFormatRaw  payloadFormat = 0
+	PayloadFormatJSON payloadFormat = 1
+)
+
+type payloadFormat int
+
+type payloadFormatVals struct {
+	Raw  payloadFormat
+	JSON payloadFormat
+	Enum types.EnumFormatter
+}
+
+// PayloadFormat defines whether the message is published as is, or wrapped in a JSON envelope.
+var PayloadFormat = &payloadFormatVals{
+	Raw:  PayloadFormatRaw,
+	JSON: PayloadFormatJSON,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"Raw",
+			"JSON",
+		}),
+}
+
+func (f payloadFormat) String() string {
+	return PayloadFormat.Enum.Print(int(f))
+}
@@ -14,9 +14,9 @@ type Config struct {
 	Title    string   `default:""                                                                                              desc:"Message title"                                    key:"title"`
 	Host     string   `default:"ntfy.sh"                                                                                       desc:"Server hostname and port"                         url:"host"`
 	Topic    string   `desc:"Target topic name"                                                                                required:""                                             url:"path"`
-	Password string   `desc:"Auth password"                                                                                    optional:""                                             url:"password"`
+	Password string   `desc:"Auth password"                                                                                    optional:""                                             secret:""      url:"password"`
 	Username string   `desc:"Auth username"                                                                                    optional:""                                             url:"user"`
-	Scheme   string   `default:"https"                                                                                         desc:"Server protocol, http or https"                   key:"scheme"`
+	Scheme   string   `default:"https"                                                                                         desc:"Server protocol, http or https"                   key:"scheme"   oneof:"http,https"`
 	Tags     []string `desc:"List of tags that may or not map to emojis"                                                       key:"tags"                                              optional:""`
 	Priority priority `default:"default"                                                                                       desc:"Message priority with 1=min, 3=default and 5=max" key:"priority"`
 	Actions  []string `desc:"Custom user action buttons for notifications, see https://docs.ntfy.sh/publish/#action-buttons"   key:"actions"                                           optional:""    sep:";"`
@@ -24,7 +24,7 @@ type Config struct {
 	Attach   string   `desc:"URL of an attachment, see attach via URL"                                                         key:"attach"                                            optional:""`
 	Filename string   `desc:"File name of the attachment"                                                                      key:"filename"                                          optional:""`
 	Delay    string   `desc:"Timestamp or duration for delayed delivery, see https://docs.ntfy.sh/publish/#scheduled-delivery" key:"delay,at,in"                                       optional:""`
-	Email    string   `desc:"E-mail address for e-mail notifications"                                                          key:"email"                                             optional:""`
+	Email    string   `desc:"E-mail address for e-mail notifications"                                                          format:"email"                                          key:"email"    optional:""`
 	Icon     string   `desc:"URL to use as notification icon"                                                                  key:"icon"                                              optional:""`
 	Cache    bool     `default:"yes"                                                                                           desc:"Cache messages"                                   key:"cache"`
 	Firebase bool     `default:"yes"                                                                                           desc:"Send to firebase"                                 key:"firebase"`
@@ -13,7 +13,7 @@ const defaultPort = 443
 
 // Config for use within the opsgenie service.
 type Config struct {
-	APIKey      string            `desc:"The OpsGenie API key"                                                                                   url:"path"`
+	APIKey      string            `desc:"The OpsGenie API key"                                                                                   secret:""                                                                url:"path"`
 	Host        string            `default:"api.opsgenie.com"                                                                                    desc:"The OpsGenie API host. Use 'api.eu.opsgenie.com' for EU instances" url:"host"`
 	Port        uint16            `default:"443"                                                                                                 desc:"The OpsGenie API port."                                            url:"port"`
 	Alias       string            `desc:"Client-defined identifier of the alert"                                                                 key:"alias"                                                              optional:"true"`
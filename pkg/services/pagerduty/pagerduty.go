@@ -0,0 +1,124 @@
+// Package pagerduty implements PagerDuty as a shoutrrr service, using the Events API v2.
+package pagerduty
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
+)
+
+// MaxSummaryLength is the maximum length of the summary of a triggered event.
+const MaxSummaryLength = 1024
+
+// messageDetailsKey is the custom details key used for the message when the title is used as the summary.
+const messageDetailsKey = "message"
+
+// Service providing PagerDuty as a notification service.
+type Service struct {
+	standard.Standard
+	Config *Config
+	pkr    format.PropKeyResolver
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier.
+func (service *Service) GetID() string {
+	return Scheme
+}
+
+// Send an event to PagerDuty, triggering, acknowledging or resolving an alert depending on the action.
+// See: https://developer.pagerduty.com/docs/events-api-v2/overview/
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this event
+	config := *service.Config
+
+	if err := service.pkr.UpdateConfigFromParams(&config, params); err != nil {
+		return err
+	}
+
+	payload, err := newEventPayload(&config, message)
+	if err != nil {
+		return err
+	}
+
+	response := apiResponse{}
+	jsonClient := jsonclient.NewClient()
+
+	if err := jsonClient.Post(config.GetAPIURL(), payload, &response); err != nil {
+		if jsonClient.ErrorResponse(err, &response) {
+			// apiResponse implements Error
+			return fmt.Errorf("failed to send PagerDuty event: %w", &response)
+		}
+
+		return fmt.Errorf("failed to send PagerDuty event: %w", err)
+	}
+
+	service.Logf("PagerDuty event %s accepted, dedup key: %s", payload.EventAction, response.DedupKey)
+
+	return nil
+}
+
+func newEventPayload(config *Config, message string) (EventPayload, error) {
+	payload := EventPayload{
+		RoutingKey:  config.IntegrationKey,
+		EventAction: config.Action.apiValue(),
+		DedupKey:    config.DedupKey,
+	}
+
+	if config.Action != ActionTrigger {
+		if config.DedupKey == "" {
+			return payload, fmt.Errorf("a dedup key is required to %s an alert", payload.EventAction)
+		}
+
+		return payload, nil
+	}
+
+	// Use the title as the summary if available, and keep the message in the custom details
+	summary := message
+	details := config.Details
+
+	if config.Title != "" {
+		summary = config.Title
+
+		if _, found := details[messageDetailsKey]; !found && message != "" {
+			details = make(map[string]string, len(config.Details)+1)
+			for key, value := range config.Details {
+				details[key] = value
+			}
+
+			details[messageDetailsKey] = message
+		}
+	}
+
+	if summary == "" {
+		return payload, errors.New("a message or title is required to trigger an alert")
+	}
+
+	payload.Payload = &EventDetails{
+		Summary:       util.Ellipsis(summary, MaxSummaryLength),
+		Source:        config.Source,
+		Severity:      config.Severity.apiValue(),
+		Component:     config.Component,
+		Group:         config.Group,
+		Class:         config.Class,
+		CustomDetails: details,
+	}
+
+	return payload, nil
+}
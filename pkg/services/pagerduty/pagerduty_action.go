@@ -0,0 +1,49 @@
+package pagerduty
+
+import (
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Event actions as constants.
+const (
+	ActionTrigger     eventAction = 0
+	ActionAcknowledge eventAction = 1
+	ActionResolve     eventAction = 2
+)
+
+type eventAction int
+
+type eventActionVals struct {
+	Trigger     eventAction
+	Acknowledge eventAction
+	Resolve     eventAction
+	Enum        types.EnumFormatter
+}
+
+// EventAction defines the actions of an event, where acknowledge and resolve apply to the alert that was
+// triggered using the same dedup key.
+var EventAction = &eventActionVals{
+	Trigger:     ActionTrigger,
+	Acknowledge: ActionAcknowledge,
+	Resolve:     ActionResolve,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"Trigger",
+			"Acknowledge",
+			"Resolve",
+		}, map[string]int{
+			"ack": int(ActionAcknowledge),
+		}),
+}
+
+func (a eventAction) String() string {
+	return EventAction.Enum.Print(int(a))
+}
+
+// apiValue returns the value used for the event_action field of the Events API.
+func (a eventAction) apiValue() string {
+	return strings.ToLower(a.String())
+}
@@ -0,0 +1,117 @@
+package pagerduty
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+const defaultPort = 443
+
+// Config for use within the PagerDuty service.
+type Config struct {
+	IntegrationKey string            `desc:"The integration (routing) key of an Events API v2 integration"                                                  required:""                                                                                           secret:""      url:"path"`
+	Host           string            `default:"events.pagerduty.com"                                                                                        desc:"The Events API host"                                                                            url:"host"`
+	Port           uint16            `default:"443"                                                                                                         desc:"The Events API port"                                                                            url:"port"`
+	Action         eventAction       `default:"Trigger"                                                                                                     desc:"The event action, acknowledge and resolve apply to the alert triggered with the same dedup key" key:"action"`
+	DedupKey       string            `desc:"Identifies the alert, required for acknowledge and resolve (generated by PagerDuty if not set when triggering)" key:"dedupkey,dedup"                                                                                  max:"255"      optional:""`
+	Severity       severity          `default:"Error"                                                                                                       desc:"The perceived severity of the status the event is describing"                                   key:"severity"`
+	Source         string            `default:"shoutrrr"                                                                                                    desc:"The unique location of the affected system, preferably a hostname or FQDN"                      key:"source"`
+	Component      string            `desc:"Component of the source machine that is responsible for the event"                                              key:"component"                                                                                       optional:""`
+	Group          string            `desc:"Logical grouping of components of a service"                                                                    key:"group"                                                                                           optional:""`
+	Class          string            `desc:"The class/type of the event"                                                                                    key:"class"                                                                                           optional:""`
+	Details        map[string]string `desc:"Additional details about the event, as key:value pairs"                                                         key:"details"                                                                                         optional:""`
+	Title          string            `default:""                                                                                                            desc:"Notification title, used as the event summary if set"                                           key:"title"`
+}
+
+// Enums returns the fields that should use a corresponding EnumFormatter to Print/Parse their values.
+func (config *Config) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"Action":   EventAction.Enum,
+		"Severity": Severity.Enum,
+	}
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+// GetAPIURL returns the URL of the Events API v2 enqueue endpoint.
+func (config *Config) GetAPIURL() string {
+	apiURL := url.URL{
+		Scheme: "https",
+		Host:   config.hostPort(),
+		Path:   "/v2/enqueue",
+	}
+
+	return apiURL.String()
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	return &url.URL{
+		Host:     config.hostPort(),
+		Path:     "/" + config.IntegrationKey,
+		Scheme:   Scheme,
+		RawQuery: format.BuildQuery(resolver),
+	}
+}
+
+// hostPort returns the host, followed by the port unless it's the default port.
+func (config *Config) hostPort() string {
+	if config.Port > 0 && config.Port != defaultPort {
+		return net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port)))
+	}
+
+	return config.Host
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	config.Host = url.Hostname()
+	config.IntegrationKey = strings.TrimPrefix(url.Path, "/")
+
+	if url.Port() != "" {
+		port, err := strconv.ParseUint(url.Port(), 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid port: %w", err)
+		}
+
+		config.Port = uint16(port)
+	} else {
+		config.Port = defaultPort
+	}
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	if url.String() != "pagerduty://dummy@dummy.com" {
+		if config.IntegrationKey == "" {
+			return errors.New("integration key missing from config URL path")
+		}
+	}
+
+	return nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "pagerduty"
+)
@@ -0,0 +1,46 @@
+package pagerduty
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EventPayload represents the event being sent to the PagerDuty Events API v2.
+//
+// See: https://developer.pagerduty.com/docs/events-api-v2/trigger-events/
+//
+// The Payload is only used when triggering an event, since acknowledge and resolve events only need the
+// dedup key of the alert that they apply to.
+type EventPayload struct {
+	RoutingKey  string        `json:"routing_key"`
+	EventAction string        `json:"event_action"`
+	DedupKey    string        `json:"dedup_key,omitempty"`
+	Payload     *EventDetails `json:"payload,omitempty"`
+}
+
+// EventDetails contains the information about the affected system of a triggered event.
+type EventDetails struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Component     string            `json:"component,omitempty"`
+	Group         string            `json:"group,omitempty"`
+	Class         string            `json:"class,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+type apiResponse struct {
+	Status   string   `json:"status"`
+	Message  string   `json:"message"`
+	DedupKey string   `json:"dedup_key"`
+	Errors   []string `json:"errors"`
+}
+
+func (r *apiResponse) Error() string {
+	msg := fmt.Sprintf("server response: %v (%v)", r.Message, r.Status)
+	if len(r.Errors) > 0 {
+		return msg + ": " + strings.Join(r.Errors, ", ")
+	}
+
+	return msg
+}
@@ -0,0 +1,50 @@
+package pagerduty
+
+import (
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Severity levels as constants.
+const (
+	SeverityCritical severity = 0
+	SeverityError    severity = 1
+	SeverityWarning  severity = 2
+	SeverityInfo     severity = 3
+)
+
+type severity int
+
+type severityVals struct {
+	Critical severity
+	Error    severity
+	Warning  severity
+	Info     severity
+	Enum     types.EnumFormatter
+}
+
+// Severity defines the perceived severity levels of the status the event is describing.
+var Severity = &severityVals{
+	Critical: SeverityCritical,
+	Error:    SeverityError,
+	Warning:  SeverityWarning,
+	Info:     SeverityInfo,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"Critical",
+			"Error",
+			"Warning",
+			"Info",
+		}),
+}
+
+func (s severity) String() string {
+	return Severity.Enum.Print(int(s))
+}
+
+// apiValue returns the value used for the severity field of the Events API.
+func (s severity) apiValue() string {
+	return strings.ToLower(s.String())
+}
@@ -0,0 +1,209 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/internal/testutils"
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+const (
+	mockIntegrationKey = "0123456789abcdef0123456789abcdef"
+	mockAPIURL         = "https://events.pagerduty.com/v2/enqueue"
+)
+
+func TestPagerDuty(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Shoutrrr PagerDuty Suite")
+}
+
+var (
+	service *Service
+	logger  *log.Logger = testutils.TestLogger()
+)
+
+var _ = ginkgo.Describe("the PagerDuty service", func() {
+	ginkgo.BeforeEach(func() {
+		service = &Service{}
+	})
+
+	ginkgo.Describe("the config", func() {
+		ginkgo.When("only required fields are set", func() {
+			ginkgo.It("should set the optional fields to the defaults", func() {
+				serviceURL := testutils.URLMust("pagerduty://events.pagerduty.com/" + mockIntegrationKey)
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				gomega.Expect(*service.Config).To(gomega.Equal(Config{
+					IntegrationKey: mockIntegrationKey,
+					Host:           "events.pagerduty.com",
+					Port:           443,
+					Action:         ActionTrigger,
+					Severity:       SeverityError,
+					Source:         "shoutrrr",
+				}))
+			})
+		})
+		ginkgo.When("the integration key is missing", func() {
+			ginkgo.It("should return an error", func() {
+				serviceURL := testutils.URLMust("pagerduty://events.pagerduty.com")
+				gomega.Expect(service.Initialize(serviceURL, logger)).NotTo(gomega.Succeed())
+			})
+		})
+		ginkgo.When("parsing the configuration URL", func() {
+			ginkgo.It("should be identical after de-/serialization", func() {
+				testURL := "pagerduty://example.com:8443/" + mockIntegrationKey +
+					"?action=Resolve&class=disk&component=db&dedupkey=disk-full&details=env%3Aprod%2Cregion%3Aeu&group=prod&severity=Critical&source=host1"
+				config := &Config{}
+				pkr := format.NewPropKeyResolver(config)
+				gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+				gomega.Expect(config.setURL(&pkr, testutils.URLMust(testURL))).To(gomega.Succeed(), "verifying")
+				gomega.Expect(config.GetURL().String()).To(gomega.Equal(testURL))
+			})
+		})
+		ginkgo.When("getting the API URL", func() {
+			ginkgo.It("should only include the port if it's not the default", func() {
+				gomega.Expect((&Config{Host: "events.pagerduty.com", Port: 443}).GetAPIURL()).
+					To(gomega.Equal(mockAPIURL))
+				gomega.Expect((&Config{Host: "localhost", Port: 8443}).GetAPIURL()).
+					To(gomega.Equal("https://localhost:8443/v2/enqueue"))
+			})
+		})
+	})
+
+	ginkgo.Describe("sending events", func() {
+		var requestBody map[string]any
+
+		ginkgo.BeforeEach(func() {
+			httpmock.Activate()
+
+			requestBody = nil
+			httpmock.RegisterResponder("POST", mockAPIURL, func(req *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(req.Body)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				requestBody = nil
+				gomega.Expect(json.Unmarshal(body, &requestBody)).To(gomega.Succeed())
+
+				return httpmock.NewJsonResponse(http.StatusAccepted, apiResponse{
+					Status:   "success",
+					Message:  "Event processed",
+					DedupKey: "generated-key",
+				})
+			})
+
+			serviceURL := testutils.URLMust("pagerduty://events.pagerduty.com/" + mockIntegrationKey +
+				"?component=db&details=env:prod")
+			gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+		})
+		ginkgo.AfterEach(func() {
+			httpmock.DeactivateAndReset()
+		})
+
+		ginkgo.It("should trigger an alert using the message as the summary", func() {
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.Succeed())
+			gomega.Expect(requestBody).To(gomega.Equal(map[string]any{
+				"routing_key":  mockIntegrationKey,
+				"event_action": "trigger",
+				"payload": map[string]any{
+					"summary":        "Disk is full",
+					"source":         "shoutrrr",
+					"severity":       "error",
+					"component":      "db",
+					"custom_details": map[string]any{"env": "prod"},
+				},
+			}))
+		})
+		ginkgo.It("should use the title as the summary and add the message to the details", func() {
+			gomega.Expect(service.Send("Only 2% left on /var", &types.Params{
+				"title":    "Disk is full",
+				"severity": "warning",
+				"dedup":    "disk-full",
+			})).To(gomega.Succeed())
+			gomega.Expect(requestBody["dedup_key"]).To(gomega.Equal("disk-full"))
+			gomega.Expect(requestBody["payload"]).To(gomega.Equal(map[string]any{
+				"summary":        "Disk is full",
+				"source":         "shoutrrr",
+				"severity":       "warning",
+				"component":      "db",
+				"custom_details": map[string]any{"env": "prod", "message": "Only 2% left on /var"},
+			}))
+			gomega.Expect(service.Config.Details).To(gomega.Equal(map[string]string{"env": "prod"}))
+		})
+		ginkgo.It("should truncate long summaries", func() {
+			longMessage := string(make([]byte, MaxSummaryLength+10))
+			gomega.Expect(service.Send(longMessage, nil)).To(gomega.Succeed())
+			summary, _ := requestBody["payload"].(map[string]any)["summary"].(string)
+			gomega.Expect(summary).To(gomega.HaveLen(MaxSummaryLength))
+		})
+		ginkgo.It("should resolve an alert using the dedup key without a payload", func() {
+			gomega.Expect(service.Send("Disk space freed", &types.Params{
+				"action":   "resolve",
+				"dedupkey": "disk-full",
+			})).To(gomega.Succeed())
+			gomega.Expect(requestBody).To(gomega.Equal(map[string]any{
+				"routing_key":  mockIntegrationKey,
+				"event_action": "resolve",
+				"dedup_key":    "disk-full",
+			}))
+		})
+		ginkgo.It("should acknowledge an alert using the ack alias", func() {
+			gomega.Expect(service.Send("", &types.Params{"action": "ack", "dedupkey": "disk-full"})).To(gomega.Succeed())
+			gomega.Expect(requestBody["event_action"]).To(gomega.Equal("acknowledge"))
+		})
+		ginkgo.It("should not use the params for subsequent events", func() {
+			gomega.Expect(service.Send("", &types.Params{"action": "resolve", "dedupkey": "disk-full"})).To(gomega.Succeed())
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.Succeed())
+			gomega.Expect(requestBody["event_action"]).To(gomega.Equal("trigger"))
+			gomega.Expect(requestBody).NotTo(gomega.HaveKey("dedup_key"))
+		})
+		ginkgo.It("should return an error when resolving without a dedup key", func() {
+			gomega.Expect(service.Send("", &types.Params{"action": "resolve"})).
+				To(gomega.MatchError("a dedup key is required to resolve an alert"))
+			gomega.Expect(requestBody).To(gomega.BeNil())
+		})
+		ginkgo.It("should return an error when triggering without a summary", func() {
+			gomega.Expect(service.Send("", nil)).To(gomega.HaveOccurred())
+			gomega.Expect(requestBody).To(gomega.BeNil())
+		})
+		ginkgo.It("should return the errors reported by the API", func() {
+			httpmock.RegisterResponder("POST", mockAPIURL, testutils.JSONRespondMust(http.StatusBadRequest, apiResponse{
+				Status:  "invalid event",
+				Message: "Event object is invalid",
+				Errors:  []string{"Length of 'routing_key' is incorrect (should be 32 characters)"},
+			}))
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.MatchError(gomega.ContainSubstring(
+				"Event object is invalid (invalid event): Length of 'routing_key' is incorrect")))
+		})
+	})
+
+	ginkgo.Describe("the basic service API", func() {
+		ginkgo.Describe("the service config", func() {
+			ginkgo.It("should implement basic service config API methods correctly", func() {
+				testutils.TestConfigGetInvalidQueryValue(&Config{})
+				testutils.TestConfigSetInvalidQueryValue(&Config{}, "pagerduty://host/key?foo=bar")
+				testutils.TestConfigSetDefaultValues(&Config{})
+				testutils.TestConfigGetEnumsCount(&Config{}, 2)
+				testutils.TestConfigGetFieldsCount(&Config{}, 10)
+			})
+		})
+		ginkgo.Describe("the service instance", func() {
+			ginkgo.It("should implement basic service API methods correctly", func() {
+				serviceURL := testutils.URLMust("pagerduty://host/" + mockIntegrationKey)
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				testutils.TestServiceSetInvalidParamValue(service, "foo", "bar")
+			})
+		})
+	})
+
+	ginkgo.It("should return the correct service ID", func() {
+		gomega.Expect(service.GetID()).To(gomega.Equal("pagerduty"))
+	})
+})
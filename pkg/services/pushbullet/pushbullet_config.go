@@ -27,7 +27,7 @@ var ErrorTokenIncorrectSize = errors.New("token has incorrect size")
 type Config struct {
 	standard.EnumlessConfig
 	Targets []string `url:"path"`
-	Token   string   `url:"host"`
+	Token   string   `secret:""                       url:"host"`
 	Title   string   `default:"Shoutrrr notification" key:"title"`
 }
 
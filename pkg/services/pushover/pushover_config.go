@@ -10,10 +10,10 @@ import (
 
 // Config for the Pushover notification service service.
 type Config struct {
-	Token    string   `desc:"API Token/Key" url:"pass"`
-	User     string   `desc:"User Key"      url:"host"`
+	Token    string   `desc:"API Token/Key" secret:""      url:"pass"`
+	User     string   `desc:"User Key"      secret:""      url:"host"`
 	Devices  []string `key:"devices"        optional:""`
-	Priority int8     `default:"0"          key:"priority"`
+	Priority int8     `default:"0"          key:"priority" max:"2"    min:"-2"`
 	Title    string   `key:"title"          optional:""`
 }
 
@@ -40,6 +40,11 @@ func (service *Service) GetID() string {
 	return Scheme
 }
 
+// ExtraParamKeys returns the keys of the params that override the username and channel of the payload.
+func (*Service) ExtraParamKeys() []string {
+	return []string{"username", "channel"}
+}
+
 // Send a notification message to Rocket.chat.
 func (service *Service) Send(message string, params *types.Params) error {
 	var res *http.Response
@@ -15,9 +15,9 @@ type Config struct {
 	UserName string `optional:"" url:"user"`
 	Host     string `url:"host"`
 	Port     string `url:"port"`
-	TokenA   string `url:"path1"`
+	TokenA   string `secret:""   url:"path1"`
 	Channel  string `url:"path3"`
-	TokenB   string `url:"path2"`
+	TokenB   string `secret:""   url:"path2"`
 }
 
 // Constants for URL path length checks.
@@ -36,13 +36,23 @@ func (config *Config) GetURL() *url.URL {
 		host = fmt.Sprintf("%s:%s", config.Host, config.Port)
 	}
 
+	path := fmt.Sprintf("/%s/%s", config.TokenA, config.TokenB)
+	if config.Channel != "" {
+		// Channels are stored with a # prefix, which is added back by SetURL
+		path += "/" + strings.TrimPrefix(config.Channel, "#")
+	}
+
 	u := &url.URL{
 		Host:       host,
-		Path:       fmt.Sprintf("%s/%s", config.TokenA, config.TokenB),
+		Path:       path,
 		Scheme:     Scheme,
 		ForceQuery: false,
 	}
 
+	if config.UserName != "" {
+		u.User = url.User(config.UserName)
+	}
+
 	return u
 }
 
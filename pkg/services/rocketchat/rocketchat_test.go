@@ -202,6 +202,16 @@ var _ = ginkgo.Describe("the rocketchat service", func() {
 			})
 		})
 
+		ginkgo.When("generating a URL from a config with a user and channel", func() {
+			ginkgo.It("should include them in the URL", func() {
+				config := &Config{}
+				testURL := "rocketchat://bot@rocketchat.my-domain.com/" + testTokenA + "/" + testTokenB + "/general"
+				gomega.Expect(config.SetURL(testutils.URLMust(testURL))).To(gomega.Succeed())
+				gomega.Expect(config.Channel).To(gomega.Equal("#general"))
+				gomega.Expect(config.GetURL().String()).To(gomega.Equal(testURL))
+			})
+		})
+
 		ginkgo.When("setting URL with a channel starting with @", func() {
 			ginkgo.It("should set channel without adding #", func() {
 				config := &Config{}
@@ -0,0 +1,85 @@
+// Package signal implements Signal as a shoutrrr service, using a self-hosted signal-cli REST API server.
+package signal
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util"
+)
+
+// Service providing Signal as a notification service.
+type Service struct {
+	standard.Standard
+	Config *Config
+	pkr    format.PropKeyResolver
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier.
+func (service *Service) GetID() string {
+	return Scheme
+}
+
+// Send a message to the recipients using the signal-cli REST API.
+// See: https://bbernhard.github.io/signal-cli-rest-api/
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this message
+	config := *service.Config
+
+	if err := service.pkr.UpdateConfigFromParams(&config, params); err != nil {
+		return err
+	}
+
+	request, err := newSendRequest(&config, message)
+	if err != nil {
+		return err
+	}
+
+	timestamp, err := NewClient(&config).Send(request)
+	if err != nil {
+		return fmt.Errorf("failed to send Signal message: %w", err)
+	}
+
+	service.Logf("Signal message sent, timestamp: %s", timestamp)
+
+	return nil
+}
+
+func newSendRequest(config *Config, message string) (*sendRequest, error) {
+	if config.Title != "" {
+		title := config.Title
+		if config.TextMode == TextModeStyled {
+			title = "**" + title + "**"
+		}
+
+		message = title + "\n" + message
+	}
+
+	attachments := util.NonEmpty(config.Attachments)
+	if message == "" && len(attachments) < 1 {
+		return nil, errors.New("a message or attachment is required")
+	}
+
+	return &sendRequest{
+		Message:           message,
+		Number:            config.Source,
+		Recipients:        config.Recipients,
+		Base64Attachments: attachments,
+		TextMode:          config.TextMode.apiValue(),
+	}, nil
+}
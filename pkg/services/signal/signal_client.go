@@ -0,0 +1,55 @@
+package signal
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
+)
+
+// Client for the signal-cli REST API.
+type Client struct {
+	config     *Config
+	jsonClient jsonclient.Client
+}
+
+// NewClient returns a new Client using the server and credentials of the config.
+func NewClient(config *Config) *Client {
+	return &Client{
+		config:     config,
+		jsonClient: jsonclient.NewClient(),
+	}
+}
+
+// Send sends the message request, returning the timestamp of the sent message.
+func (c *Client) Send(request *sendRequest) (string, error) {
+	response := sendResponse{}
+
+	if err := c.jsonClient.Post(c.config.GetAPIURL("/v2/send"), request, &response); err != nil {
+		return "", c.getError(err)
+	}
+
+	return response.Timestamp, nil
+}
+
+// ListGroups returns the groups of the account with the phone number.
+func (c *Client) ListGroups(number string) ([]Group, error) {
+	groups := []Group{}
+
+	if err := c.jsonClient.Get(c.config.GetAPIURL("/v1/groups/"+url.PathEscape(number)), &groups); err != nil {
+		return nil, c.getError(err)
+	}
+
+	return groups, nil
+}
+
+// getError returns the error message of the API response if available, or the request error otherwise.
+func (c *Client) getError(err error) error {
+	response := errorResponse{}
+	if c.jsonClient.ErrorResponse(err, &response) && response.Message != "" {
+		// errorResponse implements Error
+		return &response
+	}
+
+	return fmt.Errorf("request failed: %w", err)
+}
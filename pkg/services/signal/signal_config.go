@@ -0,0 +1,145 @@
+package signal
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Config for use within the Signal service.
+type Config struct {
+	Host        string   `desc:"signal-cli REST API server hostname and port"                                                         required:""                                                                                       url:"host,port"`
+	User        string   `desc:"Username for HTTP basic authentication"                                                               optional:""                                                                                       url:"user"`
+	Password    string   `desc:"Password for HTTP basic authentication"                                                               optional:""                                                                                       secret:""           url:"password"`
+	Source      string   `desc:"The phone number of the signal-cli account sending the messages"                                      required:""                                                                                       url:"path1"`
+	Recipients  []string `desc:"Phone numbers, usernames and group IDs (group.<id>) to send the messages to"                          required:""                                                                                       url:"path2"`
+	Title       string   `default:""                                                                                                  desc:"Notification title, shown above the message"                                                key:"title"`
+	TextMode    textMode `default:"Normal"                                                                                            desc:"Use Styled to enable *italic*, **bold**, ~strikethrough~, ||spoiler|| and monospace markup" key:"textmode,mode"`
+	Attachments []string `desc:"Base64 encoded attachments, optionally as data URIs (data:<MIME type>;filename=<name>;base64,<data>)" key:"attachments"                                                                                 optional:""         sep:"|"`
+	DisableTLS  bool     `default:"No"                                                                                                desc:"Use plain HTTP to connect to the server"                                                    key:"disabletls"`
+}
+
+// Enums returns the fields that should use a corresponding EnumFormatter to Print/Parse their values.
+func (config *Config) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"TextMode": TextMode.Enum,
+	}
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+// GetAPIURL returns the URL of the signal-cli REST API endpoint, including the basic auth credentials (if any).
+func (config *Config) GetAPIURL(endpoint string) string {
+	apiURL := url.URL{
+		Scheme: "https",
+		Host:   config.Host,
+		Path:   endpoint,
+	}
+
+	if config.DisableTLS {
+		apiURL.Scheme = "http"
+	}
+
+	if config.User != "" || config.Password != "" {
+		apiURL.User = url.UserPassword(config.User, config.Password)
+	}
+
+	return apiURL.String()
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	// Group IDs are base64 encoded, and can therefore contain slashes that need to be escaped
+	segments := append([]string{config.Source}, config.Recipients...)
+	escaped := make([]string, len(segments))
+
+	for i, segment := range segments {
+		escaped[i] = url.PathEscape(segment)
+	}
+
+	serviceURL := &url.URL{
+		Host:     config.Host,
+		Path:     "/" + strings.Join(segments, "/"),
+		RawPath:  "/" + strings.Join(escaped, "/"),
+		Scheme:   Scheme,
+		RawQuery: format.BuildQuery(resolver),
+	}
+
+	if config.User != "" || config.Password != "" {
+		serviceURL.User = url.UserPassword(config.User, config.Password)
+	}
+
+	return serviceURL
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	config.Host = url.Host
+	config.User = url.User.Username()
+	config.Password, _ = url.User.Password()
+	config.Source = ""
+	config.Recipients = []string{}
+
+	for i, segment := range strings.Split(strings.Trim(url.EscapedPath(), "/"), "/") {
+		segment, err := pathUnescape(segment)
+		if err != nil {
+			return err
+		}
+
+		if segment == "" {
+			continue
+		}
+
+		if i == 0 {
+			config.Source = segment
+		} else {
+			config.Recipients = append(config.Recipients, segment)
+		}
+	}
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	if url.String() != "signal://dummy@dummy.com" {
+		if config.Source == "" {
+			return errors.New("source phone number missing from config URL path")
+		}
+
+		if len(config.Recipients) < 1 {
+			return errors.New("no recipients specified in config URL path")
+		}
+	}
+
+	return nil
+}
+
+func pathUnescape(segment string) (string, error) {
+	unescaped, err := url.PathUnescape(segment)
+	if err != nil {
+		return "", fmt.Errorf("invalid path segment %q: %w", segment, err)
+	}
+
+	return unescaped, nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "signal"
+)
@@ -0,0 +1,126 @@
+package signal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/generator"
+)
+
+// phoneNumberPattern matches phone numbers in the international format used by signal-cli.
+var phoneNumberPattern = regexp.MustCompile(`^\+[0-9]{6,15}$`)
+
+// Generator is the signal-specific URL generator, which lists the groups of the account to pick recipients from.
+type Generator struct {
+	Reader io.Reader
+	Writer io.Writer
+}
+
+// Generate a signal Shoutrrr configuration from a user dialog.
+func (g *Generator) Generate(_ types.Service, props map[string]string, _ []string) (types.ServiceConfig, error) {
+	if g.Reader == nil {
+		g.Reader = os.Stdin
+	}
+
+	if g.Writer == nil {
+		g.Writer = os.Stdout
+	}
+
+	ud := generator.NewUserDialog(g.Reader, g.Writer, props)
+
+	config := &Config{}
+
+	config.Host = ud.QueryString("Enter the host (and port) of the signal-cli REST API server:", generator.Required, "host")
+	config.DisableTLS = ud.QueryBool("Does the server use plain HTTP (no TLS)?", "disabletls")
+	config.User = ud.QueryString("Enter the basic auth username (leave empty if not used):", nil, "user")
+
+	if config.User != "" {
+		config.Password = ud.QueryString("Enter the basic auth password:", nil, "password")
+	}
+
+	config.Source = ud.QueryString("Enter the phone number of the signal-cli account (e.g. +4412345678):",
+		generator.ValidateFormat(phoneNumberPattern.MatchString), "source")
+
+	ud.Writelnf("Fetching groups...")
+
+	groups, err := NewClient(config).ListGroups(config.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	if len(groups) > 0 {
+		ud.Writelnf("")
+		ud.Writelnf("The account is a member of these groups:")
+
+		for i, group := range groups {
+			ud.Writelnf("  %v: %v %v", format.ColorizeNumber(i+1), format.ColorizeString(group.Name),
+				format.ColorizeValue(group.ID, false))
+		}
+
+		ud.Writelnf("")
+
+		selected := ud.QueryString("Enter the numbers of the groups to send to (comma separated, or empty for none):",
+			validateGroupSelection(len(groups)), "groups")
+
+		for _, index := range parseList(selected) {
+			number, _ := strconv.Atoi(index)
+			config.Recipients = append(config.Recipients, groups[number-1].ID)
+		}
+	} else {
+		ud.Writelnf("The account is not a member of any groups.")
+	}
+
+	recipients := ud.QueryString("Enter the phone numbers of other recipients (comma separated, or empty for none):",
+		validatePhoneNumbers, "recipients")
+	config.Recipients = append(config.Recipients, parseList(recipients)...)
+
+	if len(config.Recipients) < 1 {
+		return nil, errors.New("no recipients were selected")
+	}
+
+	return config, nil
+}
+
+// validateGroupSelection returns a validator for a list of group numbers between 1 and count.
+func validateGroupSelection(count int) func(string) error {
+	return func(answer string) error {
+		for _, item := range parseList(answer) {
+			number, err := strconv.Atoi(item)
+			if err != nil || number < 1 || number > count {
+				return fmt.Errorf("%q is not a group number between 1 and %d", item, count)
+			}
+		}
+
+		return nil
+	}
+}
+
+func validatePhoneNumbers(answer string) error {
+	for _, item := range parseList(answer) {
+		if !phoneNumberPattern.MatchString(item) {
+			return fmt.Errorf("%q is not a phone number in the international format (e.g. +4412345678)", item)
+		}
+	}
+
+	return nil
+}
+
+// parseList splits a comma separated answer into its non-empty items.
+func parseList(answer string) []string {
+	items := []string{}
+
+	for _, item := range strings.Split(answer, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,90 @@
+package signal
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = ginkgo.Describe("the Signal generator", func() {
+	var (
+		mock    *mockServer
+		userOut *gbytes.Buffer
+		props   map[string]string
+	)
+
+	ginkgo.BeforeEach(func() {
+		mock = newMockServer()
+		mock.groupList = `[
+			{"name": "On-call", "id": "` + mockGroup + `", "internal_id": "YWJjZGVmZ2hp", "members": ["+4412345678"]},
+			{"name": "Friends", "id": "group.ZnJpZW5kcw==", "internal_id": "ZnJpZW5kcw==", "members": ["+4412345678"]}
+		]`
+		userOut = gbytes.NewBuffer()
+		props = map[string]string{
+			"host":       strings.TrimPrefix(mock.URL, "http://"),
+			"disabletls": "yes",
+			"user":       "",
+			"source":     mockSource,
+		}
+	})
+	ginkgo.AfterEach(func() {
+		mock.Close()
+	})
+
+	generate := func(input string) (*Config, error) {
+		generator := &Generator{Reader: strings.NewReader(input), Writer: userOut}
+
+		config, err := generator.Generate(&Service{}, props, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return config.(*Config), nil
+	}
+
+	ginkgo.It("should list the groups of the account and add the selected ones as recipients", func() {
+		config, err := generate("1\n+4487654321, +4411223344\n")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		gomega.Expect(mock.requests).To(gomega.HaveLen(1))
+		gomega.Expect(mock.requests[0].Method).To(gomega.Equal(http.MethodGet))
+		gomega.Expect(mock.requests[0].URL.Path).To(gomega.Equal("/v1/groups/" + mockSource))
+		gomega.Expect(userOut).To(gbytes.Say("On-call"))
+		gomega.Expect(userOut).To(gbytes.Say("Friends"))
+
+		gomega.Expect(config.Source).To(gomega.Equal(mockSource))
+		gomega.Expect(config.DisableTLS).To(gomega.BeTrue())
+		gomega.Expect(config.Recipients).To(gomega.Equal([]string{mockGroup, "+4487654321", "+4411223344"}))
+	})
+
+	ginkgo.It("should ask again for invalid group numbers", func() {
+		config, err := generate("3\n2,1\n\n")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(userOut).To(gbytes.Say("is not a group number between 1 and 2"))
+		gomega.Expect(config.Recipients).To(gomega.Equal([]string{"group.ZnJpZW5kcw==", mockGroup}))
+	})
+
+	ginkgo.It("should return an error if no recipients were selected", func() {
+		_, err := generate("\n\n")
+		gomega.Expect(err).To(gomega.MatchError("no recipients were selected"))
+	})
+
+	ginkgo.It("should return an error if the groups cannot be listed", func() {
+		mock.Close()
+
+		_, err := generate("")
+		gomega.Expect(err).To(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("should generate a URL that can be parsed by the service", func() {
+		config, err := generate("1\n\n")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		service := &Service{}
+		gomega.Expect(service.Initialize(config.GetURL(), logger)).To(gomega.Succeed())
+		gomega.Expect(service.Config.Recipients).To(gomega.Equal([]string{mockGroup}))
+	})
+})
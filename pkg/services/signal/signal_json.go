@@ -0,0 +1,35 @@
+package signal
+
+import "fmt"
+
+// sendRequest is the payload of the /v2/send endpoint of the signal-cli REST API.
+//
+// See: https://bbernhard.github.io/signal-cli-rest-api/#/Messages/post_v2_send
+type sendRequest struct {
+	Message           string   `json:"message"`
+	Number            string   `json:"number"`
+	Recipients        []string `json:"recipients"`
+	Base64Attachments []string `json:"base64_attachments,omitempty"`
+	TextMode          string   `json:"text_mode,omitempty"`
+}
+
+type sendResponse struct {
+	Timestamp string `json:"timestamp"`
+}
+
+// Group is a Signal group that the account is a member of, as returned by the /v1/groups endpoint.
+type Group struct {
+	Name       string   `json:"name"`
+	ID         string   `json:"id"`
+	InternalID string   `json:"internal_id"`
+	Members    []string `json:"members"`
+	Blocked    bool     `json:"blocked"`
+}
+
+type errorResponse struct {
+	Message string `json:"error"`
+}
+
+func (r *errorResponse) Error() string {
+	return fmt.Sprintf("server response: %v", r.Message)
+}
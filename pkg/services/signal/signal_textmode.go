@@ -0,0 +1,42 @@
+package signal
+
+import (
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Text modes as constants.
+const (
+	TextModeNormal textMode = 0
+	TextModeStyled textMode = 1
+)
+
+type textMode int
+
+type textModeVals struct {
+	Normal textMode
+	Styled textMode
+	Enum   types.EnumFormatter
+}
+
+// TextMode defines whether the message text is sent as is, or parsed for styling markup by signal-cli.
+var TextMode = &textModeVals{
+	Normal: TextModeNormal,
+	Styled: TextModeStyled,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"Normal",
+			"Styled",
+		}),
+}
+
+func (m textMode) String() string {
+	return TextMode.Enum.Print(int(m))
+}
+
+// apiValue returns the value used for the text_mode field of the send request.
+func (m textMode) apiValue() string {
+	return strings.ToLower(m.String())
+}
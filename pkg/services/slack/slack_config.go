@@ -13,7 +13,7 @@ type Config struct {
 	standard.EnumlessConfig
 	BotName  string `desc:"Bot name"                                                            key:"botname,username"                                                   optional:"uses bot default"`
 	Icon     string `default:""                                                                 desc:"Use emoji or URL as icon (based on presence of http(s):// prefix)" key:"icon,icon_emoji,icon_url"  optional:""`
-	Token    Token  `desc:"API Bot token"                                                       url:"user,pass"`
+	Token    Token  `desc:"API Bot token"                                                       secret:""                                                                url:"user,pass"`
 	Color    string `desc:"Message left-hand border color"                                      key:"color"                                                              optional:"default border color"`
 	Title    string `desc:"Prepended text above the message"                                    key:"title"                                                              optional:"omitted"`
 	Channel  string `desc:"Channel to send messages to in Cxxxxxxxxxx format"                   url:"host"`
@@ -34,6 +34,17 @@ func (config *Config) SetURL(url *url.URL) error {
 	return config.setURL(&resolver, url)
 }
 
+// WebhookURL returns the native Slack webhook URL for the config, or nil if it uses an API token.
+func (config *Config) WebhookURL() *url.URL {
+	if config.Token.IsAPIToken() {
+		return nil
+	}
+
+	webhookURL, _ := url.Parse(config.Token.WebhookURL())
+
+	return webhookURL
+}
+
 func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
 	return &url.URL{
 		User:       config.Token.UserInfo(),
@@ -123,3 +123,11 @@ func minInt(a, b int) int {
 
 	return b
 }
+
+// authTestResponse is the response returned by the auth.test API method.
+type authTestResponse struct {
+	Ok    bool   `json:"ok"`
+	Error string `json:"error"`
+	User  string `json:"user"`
+	Team  string `json:"team"`
+}
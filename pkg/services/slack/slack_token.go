@@ -104,8 +104,12 @@ func (token *Token) String() string {
 	return token.raw
 }
 
-// UserInfo returns a url.Userinfo struct populated from the token.
+// UserInfo returns a url.Userinfo struct populated from the token, or nil if the token has not been set.
 func (token *Token) UserInfo() *url.Userinfo {
+	if len(token.raw) < TypeIdentifierOffset {
+		return nil
+	}
+
 	return url.UserPassword(token.raw[:TypeIdentifierLength], token.raw[TypeIdentifierOffset:])
 }
 
@@ -0,0 +1,80 @@
+package slack
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
+)
+
+const apiAuthTest = "https://slack.com/api/auth.test"
+
+// verifyClient is used for the verification requests.
+var verifyClient = &http.Client{Timeout: types.VerifyTimeout}
+
+// webhookMissingTextResponses are the errors returned by Slack for a valid webhook that received an empty payload.
+var webhookMissingTextResponses = []string{"no_text", "missing_text_or_fallback_or_attachments", "invalid_payload"}
+
+// Verify checks that the token is accepted by Slack, without posting any messages.
+// API tokens are checked using the auth.test API method, while webhooks are sent an empty payload,
+// which Slack rejects without posting anything, but with a different error for unknown webhooks.
+func (service *Service) Verify() error {
+	config := service.Config
+
+	if config.Token.IsAPIToken() {
+		return service.verifyAPI(config)
+	}
+
+	return service.verifyWebhook(config)
+}
+
+func (service *Service) verifyAPI(config *Config) error {
+	response := authTestResponse{}
+	jsonClient := jsonclient.NewWithHTTPClient(verifyClient)
+	jsonClient.Headers().Set("Authorization", config.Token.Authorization())
+
+	if err := jsonClient.Post(apiAuthTest, struct{}{}, &response); err != nil {
+		return fmt.Errorf("failed to call auth.test: %w", err)
+	}
+
+	if !response.Ok {
+		if response.Error != "" {
+			return fmt.Errorf("token was not accepted: %v", response.Error)
+		}
+
+		return fmt.Errorf("token was not accepted: unknown error")
+	}
+
+	service.Logf("Authenticated as %q in team %q", response.User, response.Team)
+
+	return nil
+}
+
+func (service *Service) verifyWebhook(config *Config) error {
+	res, err := verifyClient.Post(config.Token.WebhookURL(), jsonclient.ContentType, bytes.NewBufferString("{}"))
+	if err != nil {
+		return fmt.Errorf("failed to invoke webhook: %w", err)
+	}
+
+	defer res.Body.Close()
+	resBytes, _ := io.ReadAll(res.Body)
+	response := strings.TrimSpace(string(resBytes))
+
+	if res.StatusCode == http.StatusBadRequest {
+		for _, expected := range webhookMissingTextResponses {
+			if response == expected {
+				return nil
+			}
+		}
+	}
+
+	if response == "" {
+		return fmt.Errorf("webhook was not accepted: %v", res.Status)
+	}
+
+	return fmt.Errorf("webhook was not accepted: %v", response)
+}
@@ -79,7 +79,7 @@ func (service *Service) Send(message string, params *types.Params) error {
 		return fail(FailApplySendParams, err)
 	}
 
-	client, err := getClientConnection(service.Config)
+	client, err := getClientConnection(service.Config, 0)
 	if err != nil {
 		return fail(FailGetSMTPClient, err)
 	}
@@ -87,25 +87,33 @@ func (service *Service) Send(message string, params *types.Params) error {
 	return service.doSend(client, message, &config)
 }
 
-func getClientConnection(config *Config) (*smtp.Client, error) {
+// getClientConnection connects to the server. If timeout is set, the connection fails if it's still in use after it.
+func getClientConnection(config *Config, timeout time.Duration) (*smtp.Client, error) {
 	var conn net.Conn
 
 	var err error
 
 	addr := net.JoinHostPort(config.Host, fmt.Sprintf("%d", config.Port))
+	dialer := &net.Dialer{Timeout: timeout}
 
 	if useImplicitTLS(config.Encryption, config.Port) {
-		conn, err = tls.Dial("tcp", addr, &tls.Config{
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{
 			ServerName: config.Host,
 		})
 	} else {
-		conn, err = net.Dial("tcp", addr)
+		conn, err = dialer.Dial("tcp", addr)
 	}
 
 	if err != nil {
 		return nil, fail(FailConnectToServer, err)
 	}
 
+	if timeout > 0 {
+		if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+			return nil, fail(FailConnectToServer, err)
+		}
+	}
+
 	client, err := smtp.NewClient(conn, config.Host)
 	if err != nil {
 		return nil, fail(FailCreateSMTPClient, err)
@@ -117,16 +125,40 @@ func getClientConnection(config *Config) (*smtp.Client, error) {
 func (service *Service) doSend(client *smtp.Client, message string, config *Config) failure {
 	config.FixEmailTags()
 
-	clientHost := service.resolveClientHost(config)
-
-	if err := client.Hello(clientHost); err != nil {
-		return fail(FailHandshake, err)
+	if err := service.handshake(client, config); err != nil {
+		return err
 	}
 
 	if config.UseHTML {
 		service.multipartBoundary = fmt.Sprintf("%x", rand.Int63())
 	}
 
+	for _, toAddress := range config.ToAddresses {
+		err := service.sendToRecipient(client, toAddress, config, message)
+		if err != nil {
+			return fail(FailSendRecipient, err)
+		}
+
+		service.Logf("Mail successfully sent to \"%s\"!\n", toAddress)
+	}
+
+	// Send the QUIT command and close the connection.
+	err := client.Quit()
+	if err != nil {
+		return fail(FailClosingSession, err)
+	}
+
+	return nil
+}
+
+// handshake greets the server, upgrades the connection using StartTLS if enabled and authenticates the client.
+func (service *Service) handshake(client *smtp.Client, config *Config) failure {
+	clientHost := service.resolveClientHost(config)
+
+	if err := client.Hello(clientHost); err != nil {
+		return fail(FailHandshake, err)
+	}
+
 	if config.UseStartTLS && !useImplicitTLS(config.Encryption, config.Port) {
 		if supported, _ := client.Extension("StartTLS"); !supported {
 			service.Logf("Warning: StartTLS enabled, but server did not report support for it. Connection is NOT encrypted")
@@ -147,21 +179,6 @@ func (service *Service) doSend(client *smtp.Client, message string, config *Conf
 		}
 	}
 
-	for _, toAddress := range config.ToAddresses {
-		err := service.sendToRecipient(client, toAddress, config, message)
-		if err != nil {
-			return fail(FailSendRecipient, err)
-		}
-
-		service.Logf("Mail successfully sent to \"%s\"!\n", toAddress)
-	}
-
-	// Send the QUIT command and close the connection.
-	err := client.Quit()
-	if err != nil {
-		return fail(FailClosingSession, err)
-	}
-
 	return nil
 }
 
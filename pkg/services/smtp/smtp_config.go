@@ -16,7 +16,7 @@ import (
 type Config struct {
 	Host        string    `desc:"SMTP server hostname or IP address"                   url:"Host"`
 	Username    string    `default:""                                                  desc:"SMTP server username"                                                                                            url:"User"`
-	Password    string    `default:""                                                  desc:"SMTP server password or hash (for OAuth2)"                                                                       url:"Pass"`
+	Password    string    `default:""                                                  desc:"SMTP server password or hash (for OAuth2)"                                                                       secret:""                  url:"Pass"`
 	Port        uint16    `default:"25"                                                desc:"SMTP server port, common ones are 25, 465, 587 or 2525"                                                          url:"Port"`
 	FromAddress string    `desc:"E-mail address that the mail are sent from"           key:"fromaddress,from"`
 	FromName    string    `desc:"Name of the sender"                                   key:"fromname"                                                                                                         optional:"yes"`
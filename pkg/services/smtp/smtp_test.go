@@ -222,6 +222,44 @@ var _ = ginkgo.Describe("the SMTP service", func() {
 		})
 	})
 
+	ginkgo.When("verifying the configuration", func() {
+		ginkgo.It("should authenticate without sending any mail", func() {
+			responses := []string{
+				"250-mx.google.com at your service",
+				"250-SIZE 35651584",
+				"250-AUTH LOGIN PLAIN",
+				"250 8BITMIME",
+				"235 Accepted",
+				"221 OK",
+			}
+			received, err := testVerify(BaseAuthURL, responses)
+			if msg, test := standard.IsTestSetupFailure(err); test {
+				ginkgo.Skip(msg)
+
+				return
+			}
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(received).NotTo(gomega.ContainElement("DATA"))
+			gomega.Expect(received).To(gomega.ContainElement("QUIT"))
+		})
+		ginkgo.It("should fail when the credentials are not accepted", func() {
+			responses := []string{
+				"250-mx.google.com at your service",
+				"250-SIZE 35651584",
+				"250-AUTH LOGIN PLAIN",
+				"250 8BITMIME",
+				"535 Authentication failed",
+			}
+			_, err := testVerify(BaseAuthURL, responses)
+			if msg, test := standard.IsTestSetupFailure(err); test {
+				ginkgo.Skip(msg)
+
+				return
+			}
+			gomega.Expect(err).To(matchFailure(FailAuthenticating))
+		})
+	})
+
 	ginkgo.When("running integration tests", func() {
 		ginkgo.When("given a typical usage case configuration URL", func() {
 			ginkgo.It("should send notifications without any errors", func() {
@@ -589,6 +627,35 @@ func testIntegration(testURL string, responses []string, htmlTemplate string, pl
 	return nil
 }
 
+func testVerify(testURL string, responses []string) ([]string, failures.Failure) {
+	serviceURL, err := url.Parse(testURL)
+	if err != nil {
+		return nil, standard.Failure(standard.FailParseURL, err)
+	}
+
+	if err = service.Initialize(serviceURL, logger); err != nil {
+		return nil, standard.Failure(standard.FailServiceInit, err)
+	}
+
+	textCon, tcfaker := testutils.CreateTextConFaker(responses, "\r\n")
+
+	client := &smtp.Client{
+		Text: textCon,
+	}
+
+	fakeTLSEnabled(client, serviceURL.Hostname())
+
+	ferr := service.doVerify(client, service.Config)
+
+	logger.Printf("\n%s", tcfaker.GetConversation(false))
+
+	if ferr != nil {
+		return nil, ferr
+	}
+
+	return tcfaker.GetClientSentences(), nil
+}
+
 // fakeTLSEnabled tricks a given client into believing that TLS is enabled even though it's not
 // this is needed because the SMTP library won't allow plain authentication without TLS being turned on.
 // having it turned on would of course mean that we cannot test the communication since it will be encrypted.
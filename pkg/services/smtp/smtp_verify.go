@@ -0,0 +1,31 @@
+package smtp
+
+import (
+	"net/smtp"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Verify connects to the server, performs the handshake and authenticates using the configured
+// credentials, without sending any mail.
+func (service *Service) Verify() error {
+	client, err := getClientConnection(service.Config, types.VerifyTimeout)
+	if err != nil {
+		return fail(FailGetSMTPClient, err)
+	}
+
+	return service.doVerify(client, service.Config)
+}
+
+func (service *Service) doVerify(client *smtp.Client, config *Config) failure {
+	if err := service.handshake(client, config); err != nil {
+		return err
+	}
+
+	// Send the QUIT command and close the connection.
+	if err := client.Quit(); err != nil {
+		return fail(FailClosingSession, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+// Package syslog implements sending notifications to syslog (RFC 5424) and journald as a shoutrrr service.
+package syslog
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Service sends notifications to a syslog server, a local syslog socket or journald.
+type Service struct {
+	standard.Standard
+	Config    *Config
+	pkr       format.PropKeyResolver
+	tlsConfig *tls.Config
+	now       func() time.Time
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+	service.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	service.now = time.Now
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier, which is journald if the messages are sent to journald.
+func (service *Service) GetID() string {
+	if service.Config != nil && service.Config.IsJournald() {
+		return SchemeJournald
+	}
+
+	return Scheme
+}
+
+// ExtraParamKeys returns AnyParamKey, since params that are not config props are sent as structured data (or journal fields).
+func (*Service) ExtraParamKeys() []string {
+	return []string{types.AnyParamKey}
+}
+
+// Send sends the message using the configured severity, with the params that are not config props as structured
+// data (or journal fields).
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this message
+	config := *service.Config
+
+	props, extraParams := service.splitParams(params)
+
+	if err := service.pkr.UpdateConfigFromParams(&config, &props); err != nil {
+		return err
+	}
+
+	return service.sendEntries(&config, []entry{{
+		Timestamp: service.now(),
+		Severity:  config.Severity,
+		Message:   message,
+		Params:    extraParams,
+	}})
+}
+
+// SendItems sends every item as a separate message, using the severity of the same name as the item's level (or the
+// configured severity for unknown levels), and with the item's fields added to the params.
+func (service *Service) SendItems(items []types.MessageItem, params types.Params) error {
+	// Defensive copy, since the params only apply to these messages
+	config := *service.Config
+
+	props, extraParams := service.splitParams(&params)
+
+	if err := service.pkr.UpdateConfigFromParams(&config, &props); err != nil {
+		return err
+	}
+
+	entries := make([]entry, 0, len(items))
+
+	for _, item := range items {
+		timestamp := item.Timestamp
+		if timestamp.IsZero() {
+			timestamp = service.now()
+		}
+
+		itemParams := make(map[string]string, len(extraParams)+len(item.Fields))
+		for key, value := range extraParams {
+			itemParams[key] = value
+		}
+
+		for _, field := range item.Fields {
+			itemParams[field.Key] = field.Value
+		}
+
+		entries = append(entries, entry{
+			Timestamp: timestamp,
+			Severity:  severityFromLevel(item.Level, config.Severity),
+			Message:   item.Text,
+			Params:    itemParams,
+		})
+	}
+
+	return service.sendEntries(&config, entries)
+}
+
+// sendEntries formats the entries and sends them using a single connection.
+func (service *Service) sendEntries(config *Config, entries []entry) error {
+	hostname := config.Hostname
+	if hostname == "" {
+		hostname, _ = os.Hostname()
+	}
+
+	messages := make([][]byte, 0, len(entries))
+
+	for i := range entries {
+		entries[i].Hostname = hostname
+		entries[i].ProcID = os.Getpid()
+
+		switch {
+		case config.journald:
+			messages = append(messages, createJournalMessage(config, &entries[i]))
+		case config.Transport.isStream():
+			messages = append(messages, frame(createMessage(config, &entries[i])))
+		default:
+			messages = append(messages, createMessage(config, &entries[i]))
+		}
+	}
+
+	network, address := config.GetNetwork()
+
+	conn, err := service.dial(config, network, address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", address, err)
+	}
+
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(config.Timeout)); err != nil {
+		return fmt.Errorf("failed to set the timeout: %w", err)
+	}
+
+	for _, message := range messages {
+		if _, err := conn.Write(message); err != nil {
+			return fmt.Errorf("failed to send message to %s: %w", address, err)
+		}
+	}
+
+	service.Logf("Sent %d message(s) to %s", len(messages), address)
+
+	return nil
+}
+
+func (service *Service) dial(config *Config, network string, address string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: config.Timeout}
+
+	if !config.journald && config.Transport == TransportTLS {
+		return tls.DialWithDialer(dialer, network, address, service.tlsConfig)
+	}
+
+	return dialer.Dial(network, address)
+}
+
+// splitParams splits the params into the ones that set config props, and the other ones, which are sent as
+// structured data (or journal fields).
+func (service *Service) splitParams(params *types.Params) (types.Params, map[string]string) {
+	props := types.Params{}
+	extraParams := map[string]string{}
+
+	if params == nil {
+		return props, extraParams
+	}
+
+	propKeys := service.pkr.QueryFields()
+
+	for key, value := range *params {
+		switch {
+		case slices.Contains(propKeys, strings.ToLower(key)):
+			props[key] = value
+		case key != types.MessageKey:
+			extraParams[key] = value
+		}
+	}
+
+	return props, extraParams
+}
@@ -0,0 +1,188 @@
+package syslog
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Default server ports and local sockets.
+const (
+	DefaultPort           = 514
+	DefaultTLSPort        = 6514
+	DefaultSocket         = "/dev/log"
+	DefaultJournaldSocket = "/run/systemd/journal/socket"
+)
+
+// Config for use within the syslog and journald services.
+type Config struct {
+	Host      string        `desc:"Syslog server hostname, empty when using a local socket"                                               optional:""                                                                         url:"host"`
+	Port      uint16        `desc:"Syslog server port, 514 for UDP and TCP and 6514 for TLS if not set"                                   optional:""                                                                         url:"port"`
+	Socket    string        `desc:"Path of the local socket, /dev/log for syslog and /run/systemd/journal/socket for journald if not set" optional:""                                                                         url:"path"`
+	Transport transport     `default:"UDP"                                                                                                desc:"How the messages are sent to the syslog server, or Unix for the local socket" key:"transport"`
+	Facility  facility      `default:"User"                                                                                               desc:"The type of program that is logging the messages"                             key:"facility"`
+	Severity  severity      `default:"Info"                                                                                               desc:"How urgent the messages are"                                                  key:"severity,level"`
+	AppName   string        `default:"shoutrrr"                                                                                           desc:"Name of the application sending the messages"                                 key:"appname,tag"`
+	Hostname  string        `default:""                                                                                                   desc:"Hostname included in the messages, the name of this host if empty"            key:"hostname"`
+	MsgID     string        `default:""                                                                                                   desc:"Identifies the type of the messages"                                          key:"msgid"`
+	SDID      string        `default:"shoutrrr@32473"                                                                                     desc:"ID of the structured data element that holds the params"                      key:"sdid"`
+	Title     string        `default:""                                                                                                   desc:"Notification title, prepended to the message"                                 key:"title"`
+	Timeout   time.Duration `default:"10s"                                                                                                desc:"Timeout for connecting to the server and sending the messages"                key:"timeout"`
+
+	// journald is set by the scheme of the service URL, journald or syslog
+	journald bool
+}
+
+// Enums returns the fields that should use a corresponding EnumFormatter to Print/Parse their values.
+func (config *Config) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"Transport": Transport.Enum,
+		"Facility":  Facility.Enum,
+		"Severity":  Severity.Enum,
+	}
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+// IsJournald returns whether the messages are sent to journald, using its native protocol.
+func (config *Config) IsJournald() bool {
+	return config.journald
+}
+
+// GetNetwork returns the network and address used to connect to the server or the local socket.
+func (config *Config) GetNetwork() (string, string) {
+	if config.journald {
+		return "unixgram", config.socketOrDefault(DefaultJournaldSocket)
+	}
+
+	if config.Transport == TransportUnix {
+		return "unixgram", config.socketOrDefault(DefaultSocket)
+	}
+
+	port := config.Port
+	if port == 0 {
+		port = config.defaultPort()
+	}
+
+	return config.Transport.network(), net.JoinHostPort(config.Host, strconv.Itoa(int(port)))
+}
+
+func (config *Config) socketOrDefault(defaultSocket string) string {
+	if config.Socket == "" {
+		return defaultSocket
+	}
+
+	return config.Socket
+}
+
+func (config *Config) defaultPort() uint16 {
+	if config.Transport == TransportTLS {
+		return DefaultTLSPort
+	}
+
+	return DefaultPort
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	scheme := Scheme
+	if config.journald {
+		scheme = SchemeJournald
+	}
+
+	host := config.Host
+	if config.Port > 0 && config.Port != config.defaultPort() {
+		host = net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port)))
+	}
+
+	serviceURL := &url.URL{
+		Host:     host,
+		Path:     config.Socket,
+		Scheme:   scheme,
+		RawQuery: format.BuildQuery(resolver),
+	}
+
+	// Keep the slashes of the URL when using the default local socket
+	if serviceURL.Host == "" && serviceURL.Path == "" {
+		serviceURL.Path = "/"
+	}
+
+	return serviceURL
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	config.journald = url.Scheme == SchemeJournald
+	config.Host = url.Hostname()
+	config.Socket = url.Path
+	config.Port = 0
+
+	if config.Socket == "/" {
+		config.Socket = ""
+	}
+
+	if url.Port() != "" {
+		port, err := strconv.ParseUint(url.Port(), 10, 16)
+		if err != nil {
+			return errors.New("invalid port")
+		}
+
+		config.Port = uint16(port)
+	}
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	// The dummy URL is used for both schemes, so the scheme is not compared
+	if strings.TrimPrefix(url.String(), url.Scheme) == "://dummy@dummy.com" {
+		return nil
+	}
+
+	return config.validate()
+}
+
+func (config *Config) validate() error {
+	if config.journald || config.Transport == TransportUnix {
+		if config.Host != "" {
+			return ErrorLocalHost
+		}
+
+		return nil
+	}
+
+	if config.Host == "" {
+		return ErrorMissingHost
+	}
+
+	if config.Socket != "" {
+		return ErrorSocketPath
+	}
+
+	return nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "syslog"
+	// SchemeJournald is the scheme used for sending the messages to journald.
+	SchemeJournald = "journald"
+)
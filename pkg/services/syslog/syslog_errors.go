@@ -0,0 +1,14 @@
+package syslog
+
+import "errors"
+
+var (
+	// ErrorMissingHost is returned if the syslog server is missing from the service URL of a network transport.
+	ErrorMissingHost = errors.New("missing syslog server host, use transport=unix to write to a local socket")
+
+	// ErrorLocalHost is returned if a host is specified for the local socket of the Unix transport or journald.
+	ErrorLocalHost = errors.New("a host cannot be used with a local socket")
+
+	// ErrorSocketPath is returned if a socket path is specified for a network transport.
+	ErrorSocketPath = errors.New("a socket path can only be used with transport=unix")
+)
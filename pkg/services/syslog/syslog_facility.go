@@ -0,0 +1,127 @@
+package syslog
+
+import (
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Facilities as constants, using the numerical codes of RFC 5424.
+const (
+	FacilityKern        facility = 0
+	FacilityUser        facility = 1
+	FacilityMail        facility = 2
+	FacilityDaemon      facility = 3
+	FacilityAuth        facility = 4
+	FacilitySyslog      facility = 5
+	FacilityLPR         facility = 6
+	FacilityNews        facility = 7
+	FacilityUUCP        facility = 8
+	FacilityCron        facility = 9
+	FacilityAuthPriv    facility = 10
+	FacilityFTP         facility = 11
+	FacilityNTP         facility = 12
+	FacilitySecurity    facility = 13
+	FacilityConsole     facility = 14
+	FacilitySolarisCron facility = 15
+	FacilityLocal0      facility = 16
+	FacilityLocal1      facility = 17
+	FacilityLocal2      facility = 18
+	FacilityLocal3      facility = 19
+	FacilityLocal4      facility = 20
+	FacilityLocal5      facility = 21
+	FacilityLocal6      facility = 22
+	FacilityLocal7      facility = 23
+)
+
+type facility int
+
+type facilityVals struct {
+	Kern        facility
+	User        facility
+	Mail        facility
+	Daemon      facility
+	Auth        facility
+	Syslog      facility
+	LPR         facility
+	News        facility
+	UUCP        facility
+	Cron        facility
+	AuthPriv    facility
+	FTP         facility
+	NTP         facility
+	Security    facility
+	Console     facility
+	SolarisCron facility
+	Local0      facility
+	Local1      facility
+	Local2      facility
+	Local3      facility
+	Local4      facility
+	Local5      facility
+	Local6      facility
+	Local7      facility
+	Enum        types.EnumFormatter
+}
+
+// Facility defines the type of program that is logging the messages.
+var Facility = &facilityVals{
+	Kern:        FacilityKern,
+	User:        FacilityUser,
+	Mail:        FacilityMail,
+	Daemon:      FacilityDaemon,
+	Auth:        FacilityAuth,
+	Syslog:      FacilitySyslog,
+	LPR:         FacilityLPR,
+	News:        FacilityNews,
+	UUCP:        FacilityUUCP,
+	Cron:        FacilityCron,
+	AuthPriv:    FacilityAuthPriv,
+	FTP:         FacilityFTP,
+	NTP:         FacilityNTP,
+	Security:    FacilitySecurity,
+	Console:     FacilityConsole,
+	SolarisCron: FacilitySolarisCron,
+	Local0:      FacilityLocal0,
+	Local1:      FacilityLocal1,
+	Local2:      FacilityLocal2,
+	Local3:      FacilityLocal3,
+	Local4:      FacilityLocal4,
+	Local5:      FacilityLocal5,
+	Local6:      FacilityLocal6,
+	Local7:      FacilityLocal7,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"Kern",
+			"User",
+			"Mail",
+			"Daemon",
+			"Auth",
+			"Syslog",
+			"LPR",
+			"News",
+			"UUCP",
+			"Cron",
+			"AuthPriv",
+			"FTP",
+			"NTP",
+			"Security",
+			"Console",
+			"SolarisCron",
+			"Local0",
+			"Local1",
+			"Local2",
+			"Local3",
+			"Local4",
+			"Local5",
+			"Local6",
+			"Local7",
+		}, map[string]int{
+			"kernel": int(FacilityKern),
+			"clock":  int(FacilitySolarisCron),
+			"audit":  int(FacilitySecurity),
+		}),
+}
+
+func (f facility) String() string {
+	return Facility.Enum.Print(int(f))
+}
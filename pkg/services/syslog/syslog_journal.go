@@ -0,0 +1,73 @@
+package syslog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maxJournalFieldLength is the maximum length of journal field names.
+const maxJournalFieldLength = 64
+
+// journalFields are the fields set by the service, which cannot be overridden by params.
+var journalFields = []string{"MESSAGE", "PRIORITY", "SYSLOG_FACILITY", "SYSLOG_IDENTIFIER", "SYSLOG_PID"}
+
+// createJournalMessage formats the entry using the native protocol of journald, with the params as extra fields.
+func createJournalMessage(config *Config, entry *entry) []byte {
+	buffer := &bytes.Buffer{}
+
+	writeJournalField(buffer, "MESSAGE", getText(config, entry))
+	writeJournalField(buffer, "PRIORITY", strconv.Itoa(int(entry.Severity)))
+	writeJournalField(buffer, "SYSLOG_FACILITY", strconv.Itoa(int(config.Facility)))
+	writeJournalField(buffer, "SYSLOG_IDENTIFIER", config.AppName)
+	writeJournalField(buffer, "SYSLOG_PID", strconv.Itoa(entry.ProcID))
+
+	names := make([]string, 0, len(entry.Params))
+	for name := range entry.Params {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		if field := journalFieldName(name); field != "" && !slices.Contains(journalFields, field) {
+			writeJournalField(buffer, field, entry.Params[name])
+		}
+	}
+
+	return buffer.Bytes()
+}
+
+// writeJournalField writes a field as "NAME=value\n", or using the binary format if the value contains line breaks.
+func writeJournalField(buffer *bytes.Buffer, name string, value string) {
+	if value == "" && name != "MESSAGE" {
+		return
+	}
+
+	buffer.WriteString(name)
+
+	if !strings.Contains(value, "\n") {
+		buffer.WriteByte('=')
+		buffer.WriteString(value)
+		buffer.WriteByte('\n')
+
+		return
+	}
+
+	buffer.WriteByte('\n')
+	_ = binary.Write(buffer, binary.LittleEndian, uint64(len(value)))
+	buffer.WriteString(value)
+	buffer.WriteByte('\n')
+}
+
+// journalFieldName converts a param name to a journal field name, which only consists of uppercase letters, digits
+// and underscores, and cannot start with an underscore (reserved for trusted fields) or a digit.
+func journalFieldName(name string) string {
+	field := sanitize(strings.ToUpper(name), maxJournalFieldLength, func(r rune) bool {
+		return (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_'
+	})
+
+	return strings.TrimLeft(field, "_0123456789")
+}
@@ -0,0 +1,140 @@
+package syslog
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Maximum lengths of the header fields and structured data param names of RFC 5424.
+const (
+	maxHostnameLength = 255
+	maxAppNameLength  = 48
+	maxProcIDLength   = 128
+	maxMsgIDLength    = 32
+	maxSDNameLength   = 32
+)
+
+// nilValue is used for empty header fields and missing structured data.
+const nilValue = "-"
+
+// timestampFormat is the RFC 3339 format of RFC 5424 timestamps, which allows at most 6 digits for the fractions.
+const timestampFormat = "2006-01-02T15:04:05.999999Z07:00"
+
+// entry is a single message, sent to syslog or journald.
+type entry struct {
+	Timestamp time.Time
+	Severity  severity
+	Hostname  string
+	ProcID    int
+	Message   string
+	Params    map[string]string
+}
+
+// priority returns the PRI value, combining the facility and the severity.
+func priority(facility facility, severity severity) int {
+	return int(facility)*8 + int(severity)
+}
+
+// createMessage formats the entry as an RFC 5424 message.
+func createMessage(config *Config, entry *entry) []byte {
+	header := fmt.Sprintf("<%d>1 %s %s %s %s %s %s",
+		priority(config.Facility, entry.Severity),
+		entry.Timestamp.Format(timestampFormat),
+		headerField(entry.Hostname, maxHostnameLength),
+		headerField(config.AppName, maxAppNameLength),
+		headerField(strconv.Itoa(entry.ProcID), maxProcIDLength),
+		headerField(config.MsgID, maxMsgIDLength),
+		structuredData(config.SDID, entry.Params),
+	)
+
+	text := getText(config, entry)
+	if text == "" {
+		return []byte(header)
+	}
+
+	return []byte(header + " " + text)
+}
+
+// getText returns the message, prefixed by the title if it's set.
+func getText(config *Config, entry *entry) string {
+	if config.Title == "" {
+		return entry.Message
+	}
+
+	return config.Title + ": " + entry.Message
+}
+
+// headerField replaces the characters that are not allowed in header fields, and truncates the value to maxLength.
+func headerField(value string, maxLength int) string {
+	field := sanitize(value, maxLength, func(r rune) bool {
+		return r > ' ' && r <= '~'
+	})
+
+	if field == "" {
+		return nilValue
+	}
+
+	return field
+}
+
+// structuredData returns an SD-ELEMENT containing the params, sorted by name.
+func structuredData(sdID string, params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	builder := strings.Builder{}
+
+	for _, name := range names {
+		sdName := sdNameField(name)
+		if sdName == "" {
+			continue
+		}
+
+		fmt.Fprintf(&builder, ` %s="%s"`, sdName, sdValueEscaper.Replace(params[name]))
+	}
+
+	if builder.Len() == 0 {
+		return nilValue
+	}
+
+	return "[" + sdNameField(sdID) + builder.String() + "]"
+}
+
+// sdValueEscaper escapes the characters that are not allowed in PARAM-VALUE.
+var sdValueEscaper = strings.NewReplacer(`"`, `\"`, `\`, `\\`, `]`, `\]`)
+
+// sdNameField replaces the characters that are not allowed in SD-NAME.
+func sdNameField(name string) string {
+	return sanitize(name, maxSDNameLength, func(r rune) bool {
+		return r > ' ' && r <= '~' && r != '=' && r != ']' && r != '"'
+	})
+}
+
+// sanitize replaces the runes that are not valid with underscores, and truncates the result to maxLength.
+func sanitize(value string, maxLength int, valid func(r rune) bool) string {
+	sanitized := strings.Map(func(r rune) rune {
+		if valid(r) {
+			return r
+		}
+
+		return '_'
+	}, value)
+
+	if len(sanitized) > maxLength {
+		return sanitized[:maxLength]
+	}
+
+	return sanitized
+}
+
+// frame prefixes the message with its length, using the octet counting of RFC 6587 for stream transports.
+func frame(message []byte) []byte {
+	return append([]byte(strconv.Itoa(len(message))+" "), message...)
+}
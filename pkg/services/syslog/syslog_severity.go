@@ -0,0 +1,81 @@
+package syslog
+
+import (
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Severities as constants, using the numerical codes of RFC 5424.
+const (
+	SeverityEmergency severity = 0
+	SeverityAlert     severity = 1
+	SeverityCritical  severity = 2
+	SeverityError     severity = 3
+	SeverityWarning   severity = 4
+	SeverityNotice    severity = 5
+	SeverityInfo      severity = 6
+	SeverityDebug     severity = 7
+)
+
+type severity int
+
+type severityVals struct {
+	Emergency severity
+	Alert     severity
+	Critical  severity
+	Error     severity
+	Warning   severity
+	Notice    severity
+	Info      severity
+	Debug     severity
+	Enum      types.EnumFormatter
+}
+
+// Severity defines how urgent the messages are. The message levels of shoutrrr use the severity of the same name.
+var Severity = &severityVals{
+	Emergency: SeverityEmergency,
+	Alert:     SeverityAlert,
+	Critical:  SeverityCritical,
+	Error:     SeverityError,
+	Warning:   SeverityWarning,
+	Notice:    SeverityNotice,
+	Info:      SeverityInfo,
+	Debug:     SeverityDebug,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"Emergency",
+			"Alert",
+			"Critical",
+			"Error",
+			"Warning",
+			"Notice",
+			"Info",
+			"Debug",
+		}, map[string]int{
+			"emerg": int(SeverityEmergency),
+			"panic": int(SeverityEmergency),
+			"crit":  int(SeverityCritical),
+			"err":   int(SeverityError),
+			"warn":  int(SeverityWarning),
+		}),
+}
+
+func (s severity) String() string {
+	return Severity.Enum.Print(int(s))
+}
+
+// severityFromLevel returns the severity of the same name as the message level, or the fallback for unknown levels.
+func severityFromLevel(level types.MessageLevel, fallback severity) severity {
+	switch level {
+	case types.Debug:
+		return SeverityDebug
+	case types.Info:
+		return SeverityInfo
+	case types.Warning:
+		return SeverityWarning
+	case types.Error:
+		return SeverityError
+	default:
+		return fallback
+	}
+}
@@ -0,0 +1,401 @@
+package syslog
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/internal/testutils"
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+func TestSyslog(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Shoutrrr Syslog Suite")
+}
+
+var (
+	service *Service
+	logger  *log.Logger = testutils.TestLogger()
+)
+
+var mockTime = time.Date(2024, 1, 2, 12, 30, 0, 123456789, time.UTC)
+
+// receivePacket returns the next datagram received by the listener.
+func receivePacket(listener net.PacketConn) string {
+	gomega.Expect(listener.SetReadDeadline(time.Now().Add(5 * time.Second))).To(gomega.Succeed())
+
+	buffer := make([]byte, 64*1024)
+	n, _, err := listener.ReadFrom(buffer)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	return string(buffer[:n])
+}
+
+// receiveFrames accepts a single connection, and returns the octet counted messages sent over it.
+func receiveFrames(listener net.Listener) <-chan []string {
+	received := make(chan []string, 1)
+
+	go func() {
+		defer ginkgo.GinkgoRecover()
+
+		conn, err := listener.Accept()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		messages := []string{}
+
+		for {
+			length, err := reader.ReadString(' ')
+			if err == io.EOF {
+				break
+			}
+
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			size, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			message := make([]byte, size)
+			_, err = io.ReadFull(reader, message)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			messages = append(messages, string(message))
+		}
+
+		received <- messages
+	}()
+
+	return received
+}
+
+// createCertificate creates a self-signed certificate for 127.0.0.1, returning it as a server TLS config and
+// a cert pool trusting it.
+func createCertificate() (*tls.Config, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "shoutrrr test syslog server"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	cert, err := x509.ParseCertificate(der)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		MinVersion:   tls.VersionTLS12,
+	}, pool
+}
+
+// listenUnixgram listens on a datagram socket in a temporary directory, returning the listener and its path.
+func listenUnixgram() (net.PacketConn, string) {
+	// The temporary directories of ginkgo can exceed the maximum length of socket paths
+	dir, err := os.MkdirTemp("", "shoutrrr")
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	ginkgo.DeferCleanup(os.RemoveAll, dir)
+
+	path := filepath.Join(dir, "log.sock")
+	listener, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	ginkgo.DeferCleanup(listener.Close)
+
+	return listener, path
+}
+
+var _ = ginkgo.Describe("the syslog service", func() {
+	ginkgo.BeforeEach(func() {
+		service = &Service{}
+	})
+
+	initService := func(rawURL string) {
+		gomega.Expect(service.Initialize(testutils.URLMust(rawURL), logger)).To(gomega.Succeed())
+		service.now = func() time.Time { return mockTime }
+	}
+
+	pid := os.Getpid()
+
+	ginkgo.Describe("the config", func() {
+		ginkgo.When("only required fields are set", func() {
+			ginkgo.It("should set the optional fields to the defaults", func() {
+				gomega.Expect(service.Initialize(testutils.URLMust("syslog://logs.example.com"), logger)).
+					To(gomega.Succeed())
+				gomega.Expect(*service.Config).To(gomega.Equal(Config{
+					Host:      "logs.example.com",
+					Transport: TransportUDP,
+					Facility:  FacilityUser,
+					Severity:  SeverityInfo,
+					AppName:   "shoutrrr",
+					SDID:      "shoutrrr@32473",
+					Timeout:   10 * time.Second,
+				}))
+				network, address := service.Config.GetNetwork()
+				gomega.Expect(network).To(gomega.Equal("udp"))
+				gomega.Expect(address).To(gomega.Equal("logs.example.com:514"))
+			})
+		})
+		ginkgo.It("should accept the dummy URL used for the docs of both schemes", func() {
+			for _, scheme := range []string{Scheme, SchemeJournald} {
+				gomega.Expect(service.Initialize(testutils.URLMust(scheme+"://dummy@dummy.com"), logger)).
+					To(gomega.Succeed(), scheme)
+			}
+		})
+		ginkgo.When("using a network transport", func() {
+			ginkgo.It("should use the default port of the transport", func() {
+				config := &Config{Host: "logs.example.com", Transport: TransportTCP}
+				_, address := config.GetNetwork()
+				gomega.Expect(address).To(gomega.Equal("logs.example.com:514"))
+
+				config.Transport = TransportTLS
+				network, address := config.GetNetwork()
+				gomega.Expect(network).To(gomega.Equal("tcp"))
+				gomega.Expect(address).To(gomega.Equal("logs.example.com:6514"))
+			})
+			ginkgo.It("should return an error if the host is missing", func() {
+				gomega.Expect(service.Initialize(testutils.URLMust("syslog:///dev/log"), logger)).
+					To(gomega.MatchError(ErrorMissingHost))
+			})
+			ginkgo.It("should return an error if a socket path is set", func() {
+				gomega.Expect(service.Initialize(testutils.URLMust("syslog://logs.example.com/dev/log"), logger)).
+					To(gomega.MatchError(ErrorSocketPath))
+			})
+		})
+		ginkgo.When("using a local socket", func() {
+			ginkgo.It("should use the default socket of syslog", func() {
+				initService("syslog://?transport=unix")
+				network, address := service.Config.GetNetwork()
+				gomega.Expect(network).To(gomega.Equal("unixgram"))
+				gomega.Expect(address).To(gomega.Equal(DefaultSocket))
+			})
+			ginkgo.It("should use the default socket of journald", func() {
+				initService("journald://")
+				gomega.Expect(service.Config.IsJournald()).To(gomega.BeTrue())
+				_, address := service.Config.GetNetwork()
+				gomega.Expect(address).To(gomega.Equal(DefaultJournaldSocket))
+			})
+			ginkgo.It("should return an error if a host is set", func() {
+				gomega.Expect(service.Initialize(testutils.URLMust("syslog://logs.example.com?transport=unix"), logger)).
+					To(gomega.MatchError(ErrorLocalHost))
+				gomega.Expect(service.Initialize(testutils.URLMust("journald://logs.example.com"), logger)).
+					To(gomega.MatchError(ErrorLocalHost))
+			})
+		})
+		ginkgo.When("parsing the configuration URL", func() {
+			ginkgo.DescribeTable("should be identical after de-/serialization",
+				func(testURL string) {
+					config := &Config{}
+					pkr := format.NewPropKeyResolver(config)
+					gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+					gomega.Expect(config.setURL(&pkr, testutils.URLMust(testURL))).To(gomega.Succeed(), "verifying")
+					gomega.Expect(config.GetURL().String()).To(gomega.Equal(testURL))
+				},
+				ginkgo.Entry("for a network transport", "syslog://logs.example.com:1514?appname=backup&facility=Local3"+
+					"&hostname=web-1&msgid=ALERT&sdid=meta%4032473&severity=Warning&timeout=5s&title=Backup&transport=TCP"),
+				ginkgo.Entry("for a local socket", "syslog:///var/run/syslog?transport=Unix"),
+				ginkgo.Entry("for journald", "journald:///?facility=Daemon&severity=Error"),
+			)
+		})
+	})
+
+	ginkgo.Describe("formatting messages", func() {
+		config := &Config{
+			Facility: FacilityLocal4,
+			AppName:  "shoutrrr",
+			MsgID:    "ALERT",
+			SDID:     "shoutrrr@32473",
+		}
+
+		ginkgo.It("should create an RFC 5424 message", func() {
+			message := createMessage(config, &entry{
+				Timestamp: mockTime,
+				Severity:  SeverityError,
+				Hostname:  "web-1",
+				ProcID:    42,
+				Message:   "Disk is full",
+			})
+			gomega.Expect(string(message)).To(gomega.Equal(
+				"<163>1 2024-01-02T12:30:00.123456Z web-1 shoutrrr 42 ALERT - Disk is full"))
+		})
+		ginkgo.It("should use the nil value for empty header fields", func() {
+			message := createMessage(&Config{}, &entry{Timestamp: mockTime, Severity: SeverityEmergency, ProcID: 1})
+			gomega.Expect(string(message)).To(gomega.Equal("<0>1 2024-01-02T12:30:00.123456Z - - 1 - -"))
+		})
+		ginkgo.It("should replace invalid characters in header fields", func() {
+			gomega.Expect(headerField("my app é", maxAppNameLength)).To(gomega.Equal("my_app__"))
+			gomega.Expect(headerField(strings.Repeat("a", 50), maxAppNameLength)).To(gomega.HaveLen(maxAppNameLength))
+		})
+		ginkgo.It("should add the params as structured data", func() {
+			gomega.Expect(structuredData("shoutrrr@32473", map[string]string{
+				"host":      "db-1",
+				"path":      `C:\backup "daily" [full]`,
+				"disk name": "sda",
+			})).To(gomega.Equal(
+				`[shoutrrr@32473 disk_name="sda" host="db-1" path="C:\\backup \"daily\" [full\]"]`))
+		})
+		ginkgo.It("should frame messages using octet counting", func() {
+			gomega.Expect(string(frame([]byte("<14>1 - - - - - - é")))).To(gomega.Equal("20 <14>1 - - - - - - é"))
+		})
+		ginkgo.It("should create a journal message using the binary format for multi-line values", func() {
+			message := createJournalMessage(config, &entry{
+				Severity: SeverityWarning,
+				ProcID:   42,
+				Message:  "Disk is full\nOnly 2% left",
+				Params:   map[string]string{"host": "db-1", "_uid": "0", "priority": "1", "disk-name": "sda"},
+			})
+			gomega.Expect(string(message)).To(gomega.Equal("MESSAGE\n\x19\x00\x00\x00\x00\x00\x00\x00" +
+				"Disk is full\nOnly 2% left\n" +
+				"PRIORITY=4\nSYSLOG_FACILITY=20\nSYSLOG_IDENTIFIER=shoutrrr\nSYSLOG_PID=42\n" +
+				"UID=0\nDISK_NAME=sda\nHOST=db-1\n"))
+		})
+	})
+
+	ginkgo.Describe("sending messages", func() {
+		ginkgo.It("should send the message over UDP", func() {
+			listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			defer listener.Close()
+
+			initService("syslog://" + listener.LocalAddr().String() + "?hostname=web-1&facility=daemon")
+			gomega.Expect(service.Send("Disk is full", &types.Params{
+				"title":    "Backup",
+				"severity": "crit",
+				"host":     "db-1",
+			})).To(gomega.Succeed())
+			gomega.Expect(receivePacket(listener)).To(gomega.Equal(fmt.Sprintf(
+				`<26>1 2024-01-02T12:30:00.123456Z web-1 shoutrrr %d - [shoutrrr@32473 host="db-1"] Backup: Disk is full`,
+				pid)))
+			gomega.Expect(service.Config.Severity).To(gomega.Equal(SeverityInfo))
+		})
+		ginkgo.It("should send framed messages over TCP", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			defer listener.Close()
+
+			received := receiveFrames(listener)
+
+			initService("syslog://" + listener.Addr().String() + "?hostname=web-1&transport=tcp")
+			gomega.Expect(service.SendItems([]types.MessageItem{
+				{Text: "Backup started", Level: types.Debug},
+				{Text: "Disk is full\nOnly 2% left", Level: types.Error, Fields: []types.Field{{Key: "disk", Value: "sda"}}},
+				{Text: "Backup stopped", Timestamp: mockTime.Add(time.Second)},
+			}, types.Params{"host": "db-1"})).To(gomega.Succeed())
+			gomega.Eventually(received).Should(gomega.Receive(gomega.Equal([]string{
+				fmt.Sprintf(`<15>1 2024-01-02T12:30:00.123456Z web-1 shoutrrr %d - [shoutrrr@32473 host="db-1"] `+
+					"Backup started", pid),
+				fmt.Sprintf(`<11>1 2024-01-02T12:30:00.123456Z web-1 shoutrrr %d - [shoutrrr@32473 disk="sda" host="db-1"] `+
+					"Disk is full\nOnly 2%% left", pid),
+				fmt.Sprintf(`<14>1 2024-01-02T12:30:01.123456Z web-1 shoutrrr %d - [shoutrrr@32473 host="db-1"] `+
+					"Backup stopped", pid),
+			})))
+		})
+		ginkgo.It("should send framed messages over TLS", func() {
+			serverTLS, rootCAs := createCertificate()
+			listener, err := tls.Listen("tcp", "127.0.0.1:0", serverTLS)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			defer listener.Close()
+
+			received := receiveFrames(listener)
+
+			initService("syslog://" + listener.Addr().String() + "?hostname=web-1&transport=tls")
+			service.tlsConfig.RootCAs = rootCAs
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.Succeed())
+			gomega.Eventually(received).Should(gomega.Receive(gomega.Equal([]string{
+				fmt.Sprintf("<14>1 2024-01-02T12:30:00.123456Z web-1 shoutrrr %d - - Disk is full", pid),
+			})))
+		})
+		ginkgo.It("should not trust unknown TLS certificates", func() {
+			serverTLS, _ := createCertificate()
+			listener, err := tls.Listen("tcp", "127.0.0.1:0", serverTLS)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			defer listener.Close()
+
+			go func() {
+				if conn, err := listener.Accept(); err == nil {
+					_, _ = io.Copy(io.Discard, conn)
+					_ = conn.Close()
+				}
+			}()
+
+			initService("syslog://" + listener.Addr().String() + "?transport=tls")
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.MatchError(gomega.ContainSubstring("certificate")))
+		})
+		ginkgo.It("should send the message to the local socket", func() {
+			listener, path := listenUnixgram()
+
+			initService("syslog://" + path + "?hostname=web-1&transport=unix")
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.Succeed())
+			gomega.Expect(receivePacket(listener)).To(gomega.Equal(fmt.Sprintf(
+				"<14>1 2024-01-02T12:30:00.123456Z web-1 shoutrrr %d - - Disk is full", pid)))
+		})
+		ginkgo.It("should send the message to journald", func() {
+			listener, path := listenUnixgram()
+
+			initService("journald://" + path + "?appname=backup")
+			gomega.Expect(service.Send("Disk is full", &types.Params{"title": "Backup", "level": "warn", "host": "db-1"})).
+				To(gomega.Succeed())
+			gomega.Expect(receivePacket(listener)).To(gomega.Equal(fmt.Sprintf(
+				"MESSAGE=Backup: Disk is full\nPRIORITY=4\nSYSLOG_FACILITY=1\nSYSLOG_IDENTIFIER=backup\n"+
+					"SYSLOG_PID=%d\nHOST=db-1\n", pid)))
+		})
+		ginkgo.It("should return an error if the socket does not exist", func() {
+			initService("journald:///nonexistent/journal.sock")
+			gomega.Expect(service.Send("Disk is full", nil)).To(gomega.MatchError(gomega.ContainSubstring(
+				"failed to connect to /nonexistent/journal.sock")))
+		})
+	})
+
+	ginkgo.Describe("the basic service API", func() {
+		ginkgo.Describe("the service config", func() {
+			ginkgo.It("should implement basic service config API methods correctly", func() {
+				testutils.TestConfigGetInvalidQueryValue(&Config{})
+				testutils.TestConfigSetInvalidQueryValue(&Config{}, "syslog://logs.example.com?foo=bar")
+				testutils.TestConfigSetDefaultValues(&Config{})
+				testutils.TestConfigGetEnumsCount(&Config{}, 3)
+				testutils.TestConfigGetFieldsCount(&Config{}, 11)
+			})
+		})
+	})
+
+	ginkgo.It("should return the correct service ID", func() {
+		gomega.Expect(service.GetID()).To(gomega.Equal("syslog"))
+		initService("syslog://logs.example.com")
+		gomega.Expect(service.GetID()).To(gomega.Equal("syslog"))
+		initService("journald://")
+		gomega.Expect(service.GetID()).To(gomega.Equal("journald"))
+	})
+})
@@ -0,0 +1,62 @@
+package syslog
+
+import (
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Transports as constants.
+const (
+	TransportUDP  transport = 0
+	TransportTCP  transport = 1
+	TransportTLS  transport = 2
+	TransportUnix transport = 3
+)
+
+type transport int
+
+type transportVals struct {
+	UDP  transport
+	TCP  transport
+	TLS  transport
+	Unix transport
+	Enum types.EnumFormatter
+}
+
+// Transport defines how the messages are sent to the syslog server, or to the local socket for Unix.
+var Transport = &transportVals{
+	UDP:  TransportUDP,
+	TCP:  TransportTCP,
+	TLS:  TransportTLS,
+	Unix: TransportUnix,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"UDP",
+			"TCP",
+			"TLS",
+			"Unix",
+		}, map[string]int{
+			"local": int(TransportUnix),
+		}),
+}
+
+func (t transport) String() string {
+	return Transport.Enum.Print(int(t))
+}
+
+// network returns the network used for dialing, TLS connections are made over TCP.
+func (t transport) network() string {
+	switch t {
+	case TransportTCP, TransportTLS:
+		return "tcp"
+	case TransportUnix:
+		return "unixgram"
+	default:
+		return "udp"
+	}
+}
+
+// isStream returns whether the messages need to be framed, since the transport does not preserve their boundaries.
+func (t transport) isStream() bool {
+	return t == TransportTCP || t == TransportTLS
+}
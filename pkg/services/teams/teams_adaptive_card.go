@@ -0,0 +1,135 @@
+package teams
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	adaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	adaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	adaptiveCardVersion     = "1.4"
+)
+
+// adaptiveCardMessage is the message structure posted to Workflows webhooks, wrapping an Adaptive Card.
+type adaptiveCardMessage struct {
+	Type        string                   `json:"type"`
+	Attachments []adaptiveCardAttachment `json:"attachments"`
+}
+
+// adaptiveCardAttachment represents an Adaptive Card attachment of a message.
+type adaptiveCardAttachment struct {
+	ContentType string       `json:"contentType"`
+	ContentURL  *string      `json:"contentUrl"`
+	Content     adaptiveCard `json:"content"`
+}
+
+// adaptiveCard is the main structure for an Adaptive Card.
+type adaptiveCard struct {
+	Schema  string                `json:"$schema"`
+	Type    string                `json:"type"`
+	Version string                `json:"version"`
+	Body    []adaptiveCardElement `json:"body"`
+	Actions []adaptiveCardAction  `json:"actions,omitempty"`
+}
+
+// adaptiveCardElement represents a TextBlock or FactSet element of an Adaptive Card body.
+type adaptiveCardElement struct {
+	Type   string             `json:"type"`
+	Text   string             `json:"text,omitempty"`
+	Size   string             `json:"size,omitempty"`
+	Weight string             `json:"weight,omitempty"`
+	Color  string             `json:"color,omitempty"`
+	Wrap   bool               `json:"wrap,omitempty"`
+	Facts  []adaptiveCardFact `json:"facts,omitempty"`
+}
+
+// adaptiveCardFact represents a title-value pair in an Adaptive Card FactSet.
+type adaptiveCardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// adaptiveCardAction represents an Action.OpenUrl button of an Adaptive Card.
+type adaptiveCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// createAdaptiveCardMessage creates a message with an Adaptive Card from the config and message text.
+func createAdaptiveCardMessage(config *Config, message string) (adaptiveCardMessage, error) {
+	body := []adaptiveCardElement{}
+
+	if config.Title != "" {
+		body = append(body, adaptiveCardElement{
+			Type:   "TextBlock",
+			Text:   config.Title,
+			Size:   "Large",
+			Weight: "Bolder",
+			Color:  adaptiveCardColors[config.Level],
+			Wrap:   true,
+		})
+	}
+
+	for _, line := range strings.Split(message, "\n") {
+		body = append(body, adaptiveCardElement{Type: "TextBlock", Text: line, Wrap: true})
+	}
+
+	if len(config.Facts) > 0 {
+		facts := []adaptiveCardFact{}
+		for _, name := range getFactNames(config.Facts) {
+			facts = append(facts, adaptiveCardFact{Title: name, Value: config.Facts[name]})
+		}
+
+		body = append(body, adaptiveCardElement{Type: "FactSet", Facts: facts})
+	}
+
+	actions := []adaptiveCardAction{}
+
+	for _, item := range config.Actions {
+		title, actionURL, err := parseAction(item)
+		if err != nil {
+			return adaptiveCardMessage{}, err
+		}
+
+		actions = append(actions, adaptiveCardAction{Type: "Action.OpenUrl", Title: title, URL: actionURL})
+	}
+
+	return adaptiveCardMessage{
+		Type: "message",
+		Attachments: []adaptiveCardAttachment{{
+			ContentType: adaptiveCardContentType,
+			Content: adaptiveCard{
+				Schema:  adaptiveCardSchema,
+				Type:    "AdaptiveCard",
+				Version: adaptiveCardVersion,
+				Body:    body,
+				Actions: actions,
+			},
+		}},
+	}, nil
+}
+
+// getFactNames returns the fact names in sorted order, to keep the card layout stable.
+func getFactNames(facts map[string]string) []string {
+	names := make([]string, 0, len(facts))
+	for name := range facts {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// parseAction splits an actions item into the button title and the URL it opens.
+func parseAction(item string) (string, string, error) {
+	title, actionURL, found := strings.Cut(item, "=")
+	if !found || title == "" || actionURL == "" {
+		return "", "", fmt.Errorf("invalid action %q, expected title=URL", item)
+	}
+
+	return title, actionURL, nil
+}
@@ -0,0 +1,40 @@
+package teams
+
+import (
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Card types as constants.
+const (
+	CardTypeAuto     cardType = 0
+	CardTypeMessage  cardType = 1
+	CardTypeAdaptive cardType = 2
+)
+
+type cardType int
+
+type cardTypeVals struct {
+	Auto     cardType
+	Message  cardType
+	Adaptive cardType
+	Enum     types.EnumFormatter
+}
+
+// CardType defines the payload formats, where Auto uses Adaptive Cards for Workflows webhooks (which do not
+// support message cards) and legacy message cards for Incoming Webhooks.
+var CardType = &cardTypeVals{
+	Auto:     CardTypeAuto,
+	Message:  CardTypeMessage,
+	Adaptive: CardTypeAdaptive,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"Auto",
+			"Message",
+			"Adaptive",
+		}),
+}
+
+func (c cardType) String() string {
+	return CardType.Enum.Print(int(c))
+}
@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	"github.com/nicholas-fedor/shoutrrr/pkg/format"
-	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
 	"github.com/nicholas-fedor/shoutrrr/pkg/types"
 )
 
@@ -18,17 +17,48 @@ const (
 )
 
 // Config represents the configuration for the Teams service.
+// It either uses a legacy Incoming Webhook, identified by the URL parts and host, or a Workflows webhook, where
+// the service URL is the webhook URL using the teams scheme (see IsWorkflowURL).
 type Config struct {
-	standard.EnumlessConfig
-	Group      string `optional:"" url:"user"`
+	Group      string `optional:"" secret:""   url:"user"`
 	Tenant     string `optional:"" url:"host"`
 	AltID      string `optional:"" url:"path1"`
 	GroupOwner string `optional:"" url:"path2"`
-	ExtraID    string `optional:"" url:"path3"`
+	ExtraID    string `optional:"" secret:""   url:"path3"`
 
 	Title string `key:"title" optional:""`
 	Color string `key:"color" optional:""`
-	Host  string `key:"host"  optional:""` // Required, no default
+	Host  string `key:"host"  optional:""` // Required for Incoming Webhooks, no default
+
+	Signature string            `desc:"The sig query value of a Workflows webhook URL"     key:"sig"                                         optional:"" secret:""`
+	Card      cardType          `default:"Auto"                                            desc:"The card type of the payload"               key:"card"`
+	Level     level             `default:"None"                                            desc:"The message level, used for the card color" key:"level"`
+	Facts     map[string]string `desc:"Facts shown below the message, as name:value pairs" key:"facts"                                       optional:""`
+	Actions   []string          `desc:"Buttons opening a URL, as title=URL items"          key:"actions"                                     optional:""`
+
+	workflowURL *url.URL
+}
+
+// Enums returns the fields that should use a corresponding EnumFormatter to Print/Parse their values.
+func (config *Config) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"Card":  CardType.Enum,
+		"Level": Level.Enum,
+	}
+}
+
+// IsWorkflow returns whether the config uses a Workflows webhook.
+func (config *Config) IsWorkflow() bool {
+	return config.workflowURL != nil
+}
+
+// UseAdaptiveCard returns whether the payload should be an Adaptive Card, rather than a message card.
+func (config *Config) UseAdaptiveCard() bool {
+	if config.Card == CardTypeAuto {
+		return config.IsWorkflow()
+	}
+
+	return config.Card == CardTypeAdaptive
 }
 
 // WebhookParts returns the webhook components as an array.
@@ -36,6 +66,28 @@ func (config *Config) WebhookParts() [5]string {
 	return [5]string{config.Group, config.Tenant, config.AltID, config.GroupOwner, config.ExtraID}
 }
 
+// WebhookURL returns the native Teams webhook URL for the config.
+func (config *Config) WebhookURL() *url.URL {
+	if config.IsWorkflow() {
+		webhookURL := *config.workflowURL
+		query := webhookURL.Query()
+
+		if config.Signature != "" {
+			query.Set("sig", config.Signature)
+		}
+
+		webhookURL.RawQuery = query.Encode()
+
+		return &webhookURL
+	}
+
+	webhookURL, _ := url.Parse(BuildWebhookURL(
+		config.Host, config.Group, config.Tenant, config.AltID, config.GroupOwner, config.ExtraID,
+	))
+
+	return webhookURL
+}
+
 // SetFromWebhookURL updates the Config from a Teams webhook URL.
 func (config *Config) SetFromWebhookURL(webhookURL string) error {
 	orgPattern, err := regexp.Compile(`https://([^.]+)` + WebhookDomain + `/`)
@@ -74,6 +126,14 @@ func (config *Config) GetURL() *url.URL {
 }
 
 func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	if config.IsWorkflow() {
+		serviceURL := *config.workflowURL
+		serviceURL.Scheme = Scheme
+		serviceURL.RawQuery = format.BuildQueryWithCustomFields(resolver, config.workflowURL.Query()).Encode()
+
+		return &serviceURL
+	}
+
 	if config.Host == "" {
 		return nil // Host is required
 	}
@@ -96,6 +156,12 @@ func (config *Config) SetURL(url *url.URL) error {
 // It parses the URL parts, sets query parameters, and ensures the host is specified.
 // Returns an error if the URL is invalid or the host is missing.
 func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	if IsWorkflowURL(url) {
+		return config.setWorkflowURL(resolver, url)
+	}
+
+	config.workflowURL = nil
+
 	parts, err := parseURLParts(url)
 	if err != nil {
 		return err
@@ -106,7 +172,7 @@ func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) e
 		return err
 	}
 
-	if config.Host == "" {
+	if config.Host == "" && url.String() != dummyURL {
 		return fmt.Errorf("missing required host parameter (organization.webhook.office.com)")
 	}
 	return nil
@@ -168,6 +234,29 @@ func (config *Config) setQueryParams(resolver types.ConfigQueryResolver, query u
 	return nil
 }
 
+// setWorkflowURL updates the Config from a service URL using a Workflows webhook.
+// The query values that are not config props (like api-version) are kept as part of the webhook URL.
+func (config *Config) setWorkflowURL(resolver types.ConfigQueryResolver, serviceURL *url.URL) error {
+	webhookQuery, err := format.SetConfigPropsFromQuery(resolver, serviceURL.Query())
+	if err != nil {
+		return err
+	}
+
+	if config.Signature == "" {
+		return errors.New("missing required sig parameter of the Workflows webhook URL")
+	}
+
+	config.setFromWebhookParts([5]string{})
+	config.workflowURL = &url.URL{
+		Scheme:   "https",
+		Host:     serviceURL.Host,
+		Path:     serviceURL.Path,
+		RawQuery: webhookQuery.Encode(),
+	}
+
+	return nil
+}
+
 func (config *Config) setFromWebhookParts(parts [5]string) {
 	config.Group = parts[0]
 	config.Tenant = parts[1]
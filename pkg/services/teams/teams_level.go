@@ -0,0 +1,64 @@
+package teams
+
+import (
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Message levels as constants.
+const (
+	LevelNone    level = 0
+	LevelInfo    level = 1
+	LevelSuccess level = 2
+	LevelWarning level = 3
+	LevelError   level = 4
+)
+
+type level int
+
+type levelVals struct {
+	None    level
+	Info    level
+	Success level
+	Warning level
+	Error   level
+	Enum    types.EnumFormatter
+}
+
+// Level defines the message levels, which are used to pick the title color of Adaptive Cards, and the theme
+// color of message cards unless a color has been set.
+var Level = &levelVals{
+	None:    LevelNone,
+	Info:    LevelInfo,
+	Success: LevelSuccess,
+	Warning: LevelWarning,
+	Error:   LevelError,
+	Enum: format.CreateEnumFormatter(
+		[]string{
+			"None",
+			"Info",
+			"Success",
+			"Warning",
+			"Error",
+		}),
+}
+
+// adaptiveCardColors are the Adaptive Card text colors used for the title of each level.
+var adaptiveCardColors = map[level]string{
+	LevelInfo:    "accent",
+	LevelSuccess: "good",
+	LevelWarning: "warning",
+	LevelError:   "attention",
+}
+
+// themeColors are the message card theme colors used for each level.
+var themeColors = map[level]string{
+	LevelInfo:    "0076D7",
+	LevelSuccess: "2DC72D",
+	LevelWarning: "FFC107",
+	LevelError:   "D32F2F",
+}
+
+func (l level) String() string {
+	return Level.Enum.Print(int(l))
+}
@@ -2,13 +2,14 @@ package teams
 
 // payload is the main structure for a Teams message card.
 type payload struct {
-	CardType   string    `json:"@type"`
-	Context    string    `json:"@context"`
-	ThemeColor string    `json:"themeColor,omitempty"`
-	Summary    string    `json:"summary"`
-	Title      string    `json:"title,omitempty"`
-	Markdown   bool      `json:"markdown"`
-	Sections   []section `json:"sections"`
+	CardType        string    `json:"@type"`
+	Context         string    `json:"@context"`
+	ThemeColor      string    `json:"themeColor,omitempty"`
+	Summary         string    `json:"summary"`
+	Title           string    `json:"title,omitempty"`
+	Markdown        bool      `json:"markdown"`
+	Sections        []section `json:"sections"`
+	PotentialAction []action  `json:"potentialAction,omitempty"`
 }
 
 // section represents a section of a Teams message card.
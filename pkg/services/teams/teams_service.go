@@ -54,6 +54,14 @@ func (service *Service) GetConfigURLFromCustom(customURL *url.URL) (*url.URL, er
 	if err != nil {
 		return nil, err
 	}
+	if IsWorkflowURL(tempURL) {
+		tempURL.Scheme = Scheme
+		config := &Config{}
+		if err := config.SetURL(tempURL); err != nil {
+			return nil, err
+		}
+		return config.GetURL(), nil
+	}
 	webhookURL := &url.URL{
 		Scheme: tempURL.Scheme,
 		Host:   tempURL.Host,
@@ -83,8 +91,42 @@ func (service *Service) GetConfigURLFromCustom(customURL *url.URL) (*url.URL, er
 }
 
 func (service *Service) doSend(config *Config, message string) error {
+	var body any
+	var err error
+	if config.UseAdaptiveCard() {
+		body, err = createAdaptiveCardMessage(config, message)
+	} else {
+		body, err = createMessageCard(config, message)
+	}
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	if !config.IsWorkflow() && config.Host == "" {
+		return fmt.Errorf("host is required but not specified in the configuration")
+	}
+	res, err := http.Post(config.WebhookURL().String(), "application/json", bytes.NewBuffer(payload))
+	if err != nil {
+		return fmt.Errorf("an error occurred while sending notification to teams: %s", err.Error())
+	}
+	defer res.Body.Close()
+	// Workflows webhooks respond with 202 Accepted, Incoming Webhooks with 200 OK
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf(
+			"failed to send notification to teams, response status code %s",
+			res.Status,
+		)
+	}
+	return nil
+}
+
+// createMessageCard creates a legacy Office 365 connector message card from the config and message text.
+func createMessageCard(config *Config, message string) (payload, error) {
 	lines := strings.Split(message, "\n")
-	sections := make([]section, 0, len(lines))
+	sections := make([]section, 0, len(lines)+1)
 	for _, line := range lines {
 		sections = append(sections, section{Text: line})
 	}
@@ -95,38 +137,37 @@ func (service *Service) doSend(config *Config, message string) error {
 			summary = summary[:TruncatedSummaryLen]
 		}
 	}
-	payload, err := json.Marshal(payload{
-		CardType:   "MessageCard",
-		Context:    "http://schema.org/extensions",
-		Markdown:   true,
-		Title:      config.Title,
-		ThemeColor: config.Color,
-		Summary:    summary,
-		Sections:   sections,
-	})
-	if err != nil {
-		return err
-	}
-	if config.Host == "" {
-		return fmt.Errorf("host is required but not specified in the configuration")
+	if len(config.Facts) > 0 {
+		facts := make([]fact, 0, len(config.Facts))
+		for _, name := range getFactNames(config.Facts) {
+			facts = append(facts, fact{Name: name, Value: config.Facts[name]})
+		}
+		sections = append(sections, section{Facts: facts})
 	}
-	postURL := BuildWebhookURL(
-		config.Host,
-		config.Group,
-		config.Tenant,
-		config.AltID,
-		config.GroupOwner,
-		config.ExtraID,
-	)
-	res, err := http.Post(postURL, "application/json", bytes.NewBuffer(payload))
-	if err == nil && res.StatusCode != http.StatusOK {
-		return fmt.Errorf(
-			"failed to send notification to teams, response status code %s",
-			res.Status,
-		)
+	actions := make([]action, 0, len(config.Actions))
+	for _, item := range config.Actions {
+		title, actionURL, err := parseAction(item)
+		if err != nil {
+			return payload{}, err
+		}
+		actions = append(actions, action{
+			Type:    "OpenUri",
+			Name:    title,
+			Targets: []target{{OS: "default", URI: actionURL}},
+		})
 	}
-	if err != nil {
-		return fmt.Errorf("an error occurred while sending notification to teams: %s", err.Error())
+	themeColor := config.Color
+	if themeColor == "" {
+		themeColor = themeColors[config.Level]
 	}
-	return nil
+	return payload{
+		CardType:        "MessageCard",
+		Context:         "http://schema.org/extensions",
+		Markdown:        true,
+		Title:           config.Title,
+		ThemeColor:      themeColor,
+		Summary:         summary,
+		Sections:        sections,
+		PotentialAction: actions,
+	}, nil
 }
@@ -1,14 +1,18 @@
 package teams
 
 import (
+	"encoding/json"
 	"errors"
 	"log"
+	"net/http"
 	"net/url"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
 )
 
 const (
@@ -17,6 +21,8 @@ const (
 	scopedDomainHost = "test.webhook.office.com"
 	testURLBase      = "teams://11111111-4444-4444-8444-cccccccccccc@22222222-4444-4444-8444-cccccccccccc/33333333012222222222333333333344/44444444-4444-4444-8444-cccccccccccc/" + extraIdValue
 	scopedURLBase    = testURLBase + "?host=" + scopedDomainHost
+	workflowHookURL  = "https://prod-00.westus.logic.azure.com:443/workflows/abc123/triggers/manual/paths/invoke?api-version=2016-06-01&sig=SIGNATURE&sp=%2Ftriggers%2Fmanual%2Frun&sv=1.0"
+	workflowURLBase  = "teams://prod-00.westus.logic.azure.com:443/workflows/abc123/triggers/manual/paths/invoke?api-version=2016-06-01&sig=SIGNATURE&sp=%2Ftriggers%2Fmanual%2Frun&sv=1.0"
 )
 
 var logger = log.New(ginkgo.GinkgoWriter, "Test", log.LstdFlags)
@@ -261,4 +267,158 @@ var _ = ginkgo.Describe("the teams service", func() {
 			})
 		})
 	})
+
+	ginkgo.Describe("Workflows webhooks", func() {
+		ginkgo.It("should recognize Workflows webhook URLs", func() {
+			gomega.Expect(IsWorkflowURL(urlMust(workflowHookURL))).To(gomega.BeTrue())
+			gomega.Expect(IsWorkflowURL(urlMust("https://default1234.environment.api.powerplatform.com/hook"))).To(gomega.BeTrue())
+			gomega.Expect(IsWorkflowURL(urlMust("https://example.com/powerautomate/automations/direct/invoke"))).To(gomega.BeTrue())
+			gomega.Expect(IsWorkflowURL(urlMust(scopedWebhookURL))).To(gomega.BeFalse())
+		})
+
+		ginkgo.It("should be identical after de-/serialization", func() {
+			config := &Config{}
+			serviceURL := "teams://prod-00.westus.logic.azure.com:443/workflows/abc123/triggers/manual/paths/invoke?api-version=2016-06-01&level=Warning&sig=SIGNATURE&sp=%2Ftriggers%2Fmanual%2Frun&sv=1.0&title=Alert"
+			gomega.Expect(config.SetURL(urlMust(serviceURL))).To(gomega.Succeed())
+			gomega.Expect(config.IsWorkflow()).To(gomega.BeTrue())
+			gomega.Expect(config.Signature).To(gomega.Equal("SIGNATURE"))
+			gomega.Expect(config.Level).To(gomega.Equal(LevelWarning))
+			gomega.Expect(config.GetURL().String()).To(gomega.Equal(serviceURL))
+		})
+
+		ginkgo.It("should return the native webhook URL", func() {
+			config := &Config{}
+			gomega.Expect(config.SetURL(urlMust(workflowURLBase))).To(gomega.Succeed())
+			gomega.Expect(config.WebhookURL().String()).To(gomega.Equal(workflowHookURL))
+		})
+
+		ginkgo.It("should require the sig parameter", func() {
+			config := &Config{}
+			err := config.SetURL(urlMust("teams://prod-00.westus.logic.azure.com/workflows/abc123/triggers/manual/paths/invoke"))
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("sig")))
+		})
+
+		ginkgo.It("should convert a custom URL to a service URL", func() {
+			service := Service{}
+			serviceURL, err := service.GetConfigURLFromCustom(urlMust("teams+" + workflowHookURL + "&title=Alert"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(serviceURL.String()).To(gomega.Equal(workflowURLBase + "&title=Alert"))
+		})
+
+		ginkgo.It("should use Adaptive Cards by default, and message cards for Incoming Webhooks", func() {
+			config := &Config{}
+			gomega.Expect(config.SetURL(urlMust(workflowURLBase))).To(gomega.Succeed())
+			gomega.Expect(config.UseAdaptiveCard()).To(gomega.BeTrue())
+
+			config.Card = CardTypeMessage
+			gomega.Expect(config.UseAdaptiveCard()).To(gomega.BeFalse())
+
+			gomega.Expect(config.SetURL(urlMust(scopedURLBase))).To(gomega.Succeed())
+			gomega.Expect(config.UseAdaptiveCard()).To(gomega.BeFalse())
+		})
+	})
+
+	ginkgo.Describe("sending Adaptive Cards", func() {
+		var service Service
+		var requestBody map[string]any
+
+		ginkgo.BeforeEach(func() {
+			httpmock.Activate()
+			requestBody = nil
+			service = Service{}
+			httpmock.RegisterResponder("POST", workflowHookURL, func(req *http.Request) (*http.Response, error) {
+				if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+					return nil, err
+				}
+
+				return httpmock.NewStringResponse(http.StatusAccepted, ""), nil
+			})
+		})
+		ginkgo.AfterEach(func() {
+			httpmock.DeactivateAndReset()
+		})
+
+		ginkgo.It("should accept a 202 response from the webhook", func() {
+			gomega.Expect(service.Initialize(urlMust(workflowURLBase), logger)).To(gomega.Succeed())
+			gomega.Expect(service.Send("Message", nil)).To(gomega.Succeed())
+			gomega.Expect(requestBody).To(gomega.HaveKeyWithValue("type", "message"))
+		})
+
+		ginkgo.It("should include the title, message lines, facts and actions in the card", func() {
+			gomega.Expect(service.Initialize(urlMust(workflowURLBase+"&level=Error"), logger)).To(gomega.Succeed())
+			err := service.Send("Line 1\nLine 2", &types.Params{
+				"title":   "Alert",
+				"facts":   "Status:down,Host:web-01",
+				"actions": "Dashboard=https://example.com/d/1",
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			attachment := requestBody["attachments"].([]any)[0].(map[string]any)
+			gomega.Expect(attachment).To(gomega.HaveKeyWithValue("contentType", adaptiveCardContentType))
+
+			content := attachment["content"].(map[string]any)
+			gomega.Expect(content).To(gomega.HaveKeyWithValue("type", "AdaptiveCard"))
+			gomega.Expect(content["body"]).To(gomega.Equal([]any{
+				map[string]any{
+					"type": "TextBlock", "text": "Alert", "size": "Large", "weight": "Bolder",
+					"color": "attention", "wrap": true,
+				},
+				map[string]any{"type": "TextBlock", "text": "Line 1", "wrap": true},
+				map[string]any{"type": "TextBlock", "text": "Line 2", "wrap": true},
+				map[string]any{"type": "FactSet", "facts": []any{
+					map[string]any{"title": "Host", "value": "web-01"},
+					map[string]any{"title": "Status", "value": "down"},
+				}},
+			}))
+			gomega.Expect(content["actions"]).To(gomega.Equal([]any{
+				map[string]any{"type": "Action.OpenUrl", "title": "Dashboard", "url": "https://example.com/d/1"},
+			}))
+		})
+
+		ginkgo.It("should return an error for invalid actions", func() {
+			gomega.Expect(service.Initialize(urlMust(workflowURLBase), logger)).To(gomega.Succeed())
+			err := service.Send("Message", &types.Params{"actions": "https://example.com"})
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("expected title=URL")))
+		})
+
+		ginkgo.It("should return an error if the webhook rejects the payload", func() {
+			httpmock.RegisterResponder("POST", workflowHookURL, httpmock.NewStringResponder(http.StatusBadRequest, ""))
+			gomega.Expect(service.Initialize(urlMust(workflowURLBase), logger)).To(gomega.Succeed())
+			gomega.Expect(service.Send("Message", nil)).NotTo(gomega.Succeed())
+		})
+	})
+
+	ginkgo.Describe("creating message cards", func() {
+		ginkgo.It("should use the theme color of the level unless a color is set", func() {
+			card, err := createMessageCard(&Config{Level: LevelSuccess}, "Message")
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(card.ThemeColor).To(gomega.Equal(themeColors[LevelSuccess]))
+
+			card, err = createMessageCard(&Config{Level: LevelSuccess, Color: "FF0000"}, "Message")
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(card.ThemeColor).To(gomega.Equal("FF0000"))
+		})
+
+		ginkgo.It("should add the facts as a section and the actions as OpenUri actions", func() {
+			card, err := createMessageCard(&Config{
+				Facts:   map[string]string{"Host": "web-01"},
+				Actions: []string{"Dashboard=https://example.com/d/1"},
+			}, "Message")
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(card.Sections).To(gomega.HaveLen(2))
+			gomega.Expect(card.Sections[1].Facts).To(gomega.Equal([]fact{{Name: "Host", Value: "web-01"}}))
+			gomega.Expect(card.PotentialAction).To(gomega.Equal([]action{{
+				Type:    "OpenUri",
+				Name:    "Dashboard",
+				Targets: []target{{OS: "default", URI: "https://example.com/d/1"}},
+			}}))
+		})
+	})
 })
+
+func urlMust(rawURL string) *url.URL {
+	parsed, err := url.Parse(rawURL)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	return parsed
+}
@@ -0,0 +1,29 @@
+package teams
+
+import (
+	"net/url"
+	"strings"
+)
+
+// WorkflowDomains are the domains used by Workflows (Power Automate) webhook URLs, which replace the retired
+// Office 365 connector Incoming Webhooks.
+var WorkflowDomains = []string{
+	".logic.azure.com",
+	".api.powerplatform.com",
+}
+
+// workflowPathPrefix is used by Power Automate webhook URLs on other domains.
+const workflowPathPrefix = "/powerautomate/"
+
+// IsWorkflowURL returns whether the URL is a Workflows (Power Automate) webhook URL, or a service URL using one.
+func IsWorkflowURL(webhookURL *url.URL) bool {
+	host := strings.ToLower(webhookURL.Hostname())
+
+	for _, domain := range WorkflowDomains {
+		if strings.HasSuffix(host, domain) {
+			return true
+		}
+	}
+
+	return strings.HasPrefix(webhookURL.Path, workflowPathPrefix)
+}
@@ -2,6 +2,7 @@ package telegram
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
@@ -10,6 +11,16 @@ import (
 // Client for Telegram API.
 type Client struct {
 	token string
+	// json is the client used for the API requests, jsonclient.DefaultClient if not set
+	json jsonclient.Client
+}
+
+func (c *Client) jsonClient() jsonclient.Client {
+	if c.json == nil {
+		return jsonclient.DefaultClient
+	}
+
+	return c.json
 }
 
 func (c *Client) apiURL(endpoint string) string {
@@ -19,15 +30,28 @@ func (c *Client) apiURL(endpoint string) string {
 // GetBotInfo returns the bot User info.
 func (c *Client) GetBotInfo() (*User, error) {
 	response := &userResponse{}
-	err := jsonclient.Get(c.apiURL("getMe"), response)
+	err := c.jsonClient().Get(c.apiURL("getMe"), response)
 
 	if !response.OK {
-		return nil, GetErrorResponse(jsonclient.ErrorBody(err))
+		return nil, getResponseError(err)
 	}
 
 	return &response.Result, nil
 }
 
+// GetChat returns the Chat info for the specified chat ID.
+func (c *Client) GetChat(chatID string) (*Chat, error) {
+	request := &chatRequest{ChatID: chatID}
+	response := &chatResponse{}
+	err := c.jsonClient().Post(c.apiURL("getChat"), request, response)
+
+	if !response.OK {
+		return nil, getResponseError(err)
+	}
+
+	return response.Result, nil
+}
+
 // GetUpdates retrieves the latest updates.
 func (c *Client) GetUpdates(offset int, limit int, timeout int, allowedUpdates []string) ([]Update, error) {
 	request := &updatesRequest{
@@ -37,7 +61,7 @@ func (c *Client) GetUpdates(offset int, limit int, timeout int, allowedUpdates [
 		AllowedUpdates: allowedUpdates,
 	}
 	response := &updatesResponse{}
-	err := jsonclient.Post(c.apiURL("getUpdates"), request, response)
+	err := c.jsonClient().Post(c.apiURL("getUpdates"), request, response)
 
 	if !response.OK {
 		return nil, GetErrorResponse(jsonclient.ErrorBody(err))
@@ -49,7 +73,7 @@ func (c *Client) GetUpdates(offset int, limit int, timeout int, allowedUpdates [
 // SendMessage sends the specified Message.
 func (c *Client) SendMessage(message *SendMessagePayload) (*Message, error) {
 	response := &messageResponse{}
-	err := jsonclient.Post(c.apiURL("sendMessage"), message, response)
+	err := c.jsonClient().Post(c.apiURL("sendMessage"), message, response)
 
 	if !response.OK {
 		return nil, GetErrorResponse(jsonclient.ErrorBody(err))
@@ -58,6 +82,20 @@ func (c *Client) SendMessage(message *SendMessagePayload) (*Message, error) {
 	return response.Result, nil
 }
 
+// getResponseError returns the API error for a failed request, falling back to the request error
+// if the response did not contain any error details.
+func getResponseError(err error) error {
+	if apiError := GetErrorResponse(jsonclient.ErrorBody(err)); apiError != nil {
+		return apiError
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return errors.New("the API returned an unsuccessful response")
+}
+
 // GetErrorResponse retrieves the error message from a failed request.
 func GetErrorResponse(body string) error {
 	response := &errorResponse{}
@@ -12,7 +12,7 @@ import (
 
 // Config for use within the telegram plugin.
 type Config struct {
-	Token        string    `url:"user"`
+	Token        string    `pattern:"^[0-9]+:[a-zA-Z0-9_-]+$"                      required:""                                                        secret:""      url:"user"`
 	Preview      bool      `default:"Yes"                                          desc:"If disabled, no web page preview will be displayed for URLs" key:"preview"`
 	Notification bool      `default:"Yes"                                          desc:"If disabled, sends Message silently"                         key:"notification"`
 	ParseMode    parseMode `default:"None"                                         desc:"How the text Message should be parsed"                       key:"parsemode"`
@@ -42,10 +42,10 @@ func (config *Config) SetURL(url *url.URL) error {
 }
 
 func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
-	tokenParts := strings.Split(config.Token, ":")
+	botID, secret, _ := strings.Cut(config.Token, ":")
 
 	return &url.URL{
-		User:       url.UserPassword(tokenParts[0], tokenParts[1]),
+		User:       url.UserPassword(botID, secret),
 		Host:       Scheme,
 		Scheme:     Scheme,
 		ForceQuery: true,
@@ -58,6 +58,7 @@ func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) e
 
 	token := url.User.Username() + ":" + password
 	if url.String() != "telegram://dummy@dummy.com" {
+		// The token is validated using the rules of the Token field
 		if !IsTokenValid(token) {
 			return fmt.Errorf("invalid telegram token %s", token)
 		}
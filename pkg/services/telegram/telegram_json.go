@@ -108,6 +108,15 @@ type User struct {
 	SupportsInlineQueries bool `json:"supports_inline_queries"`
 }
 
+type chatRequest struct {
+	ChatID string `json:"chat_id"`
+}
+
+type chatResponse struct {
+	OK     bool  `json:"ok"`
+	Result *Chat `json:"result"`
+}
+
 type updatesRequest struct {
 	Offset         int      `json:"offset"`
 	Limit          int      `json:"limit"`
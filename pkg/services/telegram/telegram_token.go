@@ -1,10 +1,14 @@
 package telegram
 
-import "regexp"
+import "github.com/nicholas-fedor/shoutrrr/pkg/format"
 
-// IsTokenValid for use with telegram.
+// IsTokenValid returns whether the token satisfies the validation rules of the Token config field.
 func IsTokenValid(token string) bool {
-	matched, err := regexp.MatchString("^[0-9]+:[a-zA-Z0-9_-]+$", token)
+	for _, node := range format.GetConfigFormat(&Config{}).Items {
+		if field := node.Field(); field.Name == "Token" {
+			return format.ValidateFieldValue(field, token) == nil
+		}
+	}
 
-	return matched && err == nil
+	return false
 }
@@ -0,0 +1,35 @@
+package telegram
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
+)
+
+// verifyClient is used for the verification requests.
+var verifyClient = &http.Client{Timeout: types.VerifyTimeout}
+
+// Verify checks that the bot token is accepted by the API and that the bot has access to the
+// configured chats, without sending any messages.
+func (service *Service) Verify() error {
+	client := &Client{token: service.Config.Token, json: jsonclient.NewWithHTTPClient(verifyClient)}
+
+	bot, err := client.GetBotInfo()
+	if err != nil {
+		return fmt.Errorf("bot token was not accepted: %w", err)
+	}
+
+	service.Logf("Authenticated as bot @%v", bot.Username)
+
+	for _, chat := range service.Config.Chats {
+		chatID, _, _ := strings.Cut(chat, ":")
+		if _, err := client.GetChat(chatID); err != nil {
+			return fmt.Errorf("chat %q is not accessible by the bot: %w", chatID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+// Package twilio implements Twilio SMS as a shoutrrr service, using the Programmable Messaging API.
+package twilio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util"
+)
+
+// Service sends SMS messages using the Twilio Messages API.
+type Service struct {
+	standard.Standard
+	Config *Config
+	pkr    format.PropKeyResolver
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier.
+func (service *Service) GetID() string {
+	return Scheme
+}
+
+// Send a notification message as SMS to all the phone numbers, splitting it into multiple messages if it does not
+// fit into the configured number of segments.
+// See: https://www.twilio.com/docs/messaging/api/message-resource#create-a-message-resource
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this message
+	config := *service.Config
+
+	if err := service.pkr.UpdateConfigFromParams(&config, params); err != nil {
+		return err
+	}
+
+	if config.Title != "" {
+		message = config.Title + "\n" + message
+	}
+
+	items, omitted := util.PartitionMessage(message, getMessageLimits(message, int(config.Segments)), MaxSearchRunes)
+	if omitted > 0 {
+		service.Logf("Message too long for %d SMS messages, %d characters omitted", MaxMessages, omitted)
+	}
+
+	errs := []error{}
+
+	for _, to := range config.To {
+		for _, item := range items {
+			if err := service.sendMessage(&config, to, item.Text); err != nil {
+				errs = append(errs, fmt.Errorf("failed to send SMS to %s: %w", to, err))
+
+				// The remaining parts would not make sense without this one
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (service *Service) sendMessage(config *Config, to string, body string) error {
+	data := url.Values{}
+	data.Set("To", to)
+	data.Set("Body", body)
+
+	if config.UsesMessagingService() {
+		data.Set("MessagingServiceSid", config.From)
+	} else {
+		data.Set("From", config.From)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, config.GetAPIURL(), strings.NewReader(data.Encode()))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(config.AccountSID, config.AuthToken)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	resBytes, _ := io.ReadAll(res.Body)
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		response := errorResponse{}
+		if err := json.Unmarshal(resBytes, &response); err == nil && response.Message != "" {
+			// errorResponse implements Error
+			return &response
+		}
+
+		return fmt.Errorf("server returned %v", res.Status)
+	}
+
+	response := messageResponse{}
+	if err := json.Unmarshal(resBytes, &response); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	service.Logf("Twilio message %s to %s %s", response.SID, to, response.Status)
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package twilio
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// messagingServicePrefix is the prefix of Messaging Service SIDs, which can be used instead of a from number.
+const messagingServicePrefix = "MG"
+
+// Config for the Twilio service.
+type Config struct {
+	standard.EnumlessConfig
+	AccountSID string   `desc:"Account SID (AC...)"                                                     required:""                                                                  url:"user"`
+	AuthToken  string   `desc:"Auth token"                                                              required:""                                                                  secret:""      url:"password"`
+	From       string   `desc:"Phone number or Messaging Service SID (MG...) to send the messages from" required:""                                                                  url:"host"`
+	To         []string `desc:"Phone numbers to send the messages to"                                   required:""                                                                  url:"path"`
+	Title      string   `default:""                                                                     desc:"Notification title, sent above the message"                            key:"title"`
+	Segments   uint8    `default:"10"                                                                   desc:"Maximum number of SMS segments per message, longer messages are split" key:"segments" max:"10"       min:"1"`
+	BaseURL    string   `default:"https://api.twilio.com"                                               desc:"Base URL of the Twilio API"                                            format:"url"   key:"baseurl"`
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+// GetAPIURL returns the URL of the Messages endpoint of the account.
+func (config *Config) GetAPIURL() string {
+	return strings.TrimSuffix(config.BaseURL, "/") + "/2010-04-01/Accounts/" + url.PathEscape(config.AccountSID) +
+		"/Messages.json"
+}
+
+// UsesMessagingService returns whether the messages are sent using a Messaging Service instead of a from number.
+func (config *Config) UsesMessagingService() bool {
+	return strings.HasPrefix(config.From, messagingServicePrefix)
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	return &url.URL{
+		User:     url.UserPassword(config.AccountSID, config.AuthToken),
+		Host:     config.From,
+		Path:     "/" + strings.Join(config.To, "/"),
+		Scheme:   Scheme,
+		RawQuery: format.BuildQuery(resolver),
+	}
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	password, _ := url.User.Password()
+	config.AccountSID = url.User.Username()
+	config.AuthToken = password
+	config.From = url.Host
+	config.To = []string{}
+
+	for _, number := range strings.Split(url.Path, "/") {
+		if number != "" {
+			config.To = append(config.To, number)
+		}
+	}
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	if url.String() != "twilio://dummy@dummy.com" {
+		return config.validate()
+	}
+
+	return nil
+}
+
+func (config *Config) validate() error {
+	if config.AccountSID == "" || config.AuthToken == "" {
+		return ErrorMissingCredentials
+	}
+
+	if config.From == "" {
+		return ErrorMissingFrom
+	}
+
+	if len(config.To) < 1 {
+		return ErrorMissingTo
+	}
+
+	return nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "twilio"
+)
@@ -0,0 +1,14 @@
+package twilio
+
+import "errors"
+
+var (
+	// ErrorMissingCredentials is returned if the account SID or auth token is missing from the service URL.
+	ErrorMissingCredentials = errors.New("missing account SID or auth token")
+
+	// ErrorMissingFrom is returned if neither a from number nor a Messaging Service SID has been specified.
+	ErrorMissingFrom = errors.New("missing from number or Messaging Service SID")
+
+	// ErrorMissingTo is returned if no phone numbers to send the messages to have been specified.
+	ErrorMissingTo = errors.New("missing phone numbers to send the messages to")
+)
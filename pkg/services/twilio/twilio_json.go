@@ -0,0 +1,23 @@
+package twilio
+
+import "fmt"
+
+// messageResponse is the part of the created message resource that is used by the service.
+//
+// See: https://www.twilio.com/docs/messaging/api/message-resource
+type messageResponse struct {
+	SID    string `json:"sid"`
+	Status string `json:"status"`
+}
+
+// errorResponse is the body of an API error response.
+type errorResponse struct {
+	Code     int    `json:"code"`
+	Message  string `json:"message"`
+	MoreInfo string `json:"more_info"`
+	Status   int    `json:"status"`
+}
+
+func (r *errorResponse) Error() string {
+	return fmt.Sprintf("api response: %v (code %v)", r.Message, r.Code)
+}
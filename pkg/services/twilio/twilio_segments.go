@@ -0,0 +1,70 @@
+package twilio
+
+import (
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// SMS segment sizes, in characters, for single and concatenated (multi-segment) messages.
+const (
+	GSMSegmentSize        = 160
+	GSMConcatSegmentSize  = 153
+	UCS2SegmentSize       = 70
+	UCS2ConcatSegmentSize = 67
+)
+
+// MaxMessages is the maximum number of messages a notification is split into, the rest of it is omitted.
+const MaxMessages = 10
+
+// MaxSearchRunes is the maximum number of runes to search for a whitespace to split the messages on.
+const MaxSearchRunes = 20
+
+// gsmBasicChars are the characters of the GSM 03.38 default alphabet.
+const gsmBasicChars = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// gsmExtensionChars are the characters of the GSM 03.38 extension table, which take up two characters each.
+const gsmExtensionChars = "\f^{}\\[~]|€"
+
+// getMessageLimits returns the limits for splitting the message into SMS messages of at most segments segments.
+// The GSM 03.38 encoding is used if possible, otherwise the message is sent as UCS-2.
+func getMessageLimits(message string, segments int) types.MessageLimit {
+	singleSize, concatSize := GSMSegmentSize, GSMConcatSegmentSize
+	wideChars := 0
+
+	for _, char := range message {
+		if strings.ContainsRune(gsmExtensionChars, char) {
+			wideChars++
+		} else if !strings.ContainsRune(gsmBasicChars, char) {
+			singleSize, concatSize = UCS2SegmentSize, UCS2ConcatSegmentSize
+
+			break
+		}
+	}
+
+	if singleSize == UCS2SegmentSize {
+		// Characters outside of the basic multilingual plane are encoded as UTF-16 surrogate pairs
+		wideChars = 0
+
+		for _, char := range message {
+			if char > 0xFFFF {
+				wideChars++
+			}
+		}
+	}
+
+	capacity := singleSize
+	if segments > 1 {
+		capacity = segments * concatSize
+	}
+
+	// Every wide character could end up in the same message, but it never takes up more than twice the space
+	chunkSize := max(capacity-wideChars, capacity/2)
+
+	return types.MessageLimit{
+		ChunkSize:      chunkSize,
+		TotalChunkSize: chunkSize * MaxMessages,
+		ChunkCount:     MaxMessages + 1,
+	}
+}
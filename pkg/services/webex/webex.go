@@ -0,0 +1,164 @@
+// Package webex implements Webex (Cisco Webex, formerly Webex Teams) as a shoutrrr service.
+package webex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
+)
+
+const (
+	// DefaultRetryDelay is used when a rate limited response does not contain a valid Retry-After header.
+	DefaultRetryDelay = time.Second
+
+	// MaxRetryDelay is the longest Retry-After delay that is waited for before giving up.
+	MaxRetryDelay = time.Minute
+)
+
+// Service sends notifications to a Webex room or person, using a bot access token.
+type Service struct {
+	standard.Standard
+	Config *Config
+	pkr    format.PropKeyResolver
+	sleep  func(time.Duration)
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+	service.sleep = time.Sleep
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier.
+func (service *Service) GetID() string {
+	return Scheme
+}
+
+// Send a notification message to Webex.
+// See: https://developer.webex.com/docs/api/v1/messages/create-a-message
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this message
+	config := *service.Config
+
+	if err := service.pkr.UpdateConfigFromParams(&config, params); err != nil {
+		return err
+	}
+
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(createPayload(&config, message))
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	for attempt := uint8(0); ; attempt++ {
+		res, err := service.post(&config, body)
+		if err != nil {
+			return fmt.Errorf("failed to send webex notification: %w", err)
+		}
+
+		if res.StatusCode != http.StatusTooManyRequests || attempt >= config.MaxRetries {
+			return handleResponse(res)
+		}
+
+		delay := parseRetryAfter(res.Header.Get("Retry-After"), time.Now())
+		_ = res.Body.Close()
+
+		if delay > MaxRetryDelay {
+			return fmt.Errorf("failed to send webex notification: rate limited for %v", delay)
+		}
+
+		service.Logf("Webex API rate limit reached, retrying in %v", delay)
+		service.sleep(delay)
+	}
+}
+
+func (service *Service) post(config *Config, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, config.GetAPIURL(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", jsonclient.ContentType)
+	req.Header.Set("Authorization", "Bearer "+config.Token)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending payload: %w", err)
+	}
+
+	return res, nil
+}
+
+func handleResponse(res *http.Response) error {
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	resBytes, _ := io.ReadAll(res.Body)
+
+	response := errorResponse{}
+	if err := json.Unmarshal(resBytes, &response); err == nil && response.Message != "" {
+		// errorResponse implements Error
+		return fmt.Errorf("failed to send webex notification: %w", &response)
+	}
+
+	return fmt.Errorf("failed to send webex notification: server returned %v", res.Status)
+}
+
+func createPayload(config *Config, message string) MessagePayload {
+	if config.Title != "" {
+		message = fmt.Sprintf("**%s**\n\n%s", config.Title, message)
+	}
+
+	payload := MessagePayload{
+		RoomID:      config.RoomID,
+		PersonEmail: config.PersonEmail,
+		ParentID:    config.ParentID,
+		Markdown:    message,
+	}
+
+	if config.FileURL != "" {
+		payload.Files = []string{config.FileURL}
+	}
+
+	return payload
+}
+
+// parseRetryAfter returns the delay from a Retry-After header value, which is either a number of seconds
+// or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay
+		}
+
+		return 0
+	}
+
+	return DefaultRetryDelay
+}
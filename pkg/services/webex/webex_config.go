@@ -0,0 +1,96 @@
+package webex
+
+import (
+	"net/url"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// Config for the Webex service.
+type Config struct {
+	standard.EnumlessConfig
+	Token       string `desc:"Bot access token"                                        required:""                                       secret:""               url:"user"`
+	Host        string `default:"webexapis.com"                                        desc:"Webex API hostname"                         url:"host"`
+	RoomID      string `desc:"ID of the room (space) to post the messages to"          key:"room,roomid"                                 optional:""`
+	PersonEmail string `desc:"E-mail address of the person to send direct messages to" format:"email"                                    key:"email,personemail" optional:""`
+	Title       string `default:""                                                     desc:"Prepended in bold above the message"        key:"title"`
+	FileURL     string `desc:"Public URL of a file to attach to the messages"          format:"url"                                      key:"file"              optional:""`
+	ParentID    string `desc:"ID of the parent message, to reply in a thread"          key:"parentid"                                    optional:""`
+	MaxRetries  uint8  `default:"3"                                                    desc:"Number of retries of rate limited requests" key:"maxretries"        max:"10"    min:"0"`
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+// GetAPIURL returns the URL of the messages endpoint of the Webex API.
+func (config *Config) GetAPIURL() string {
+	return (&url.URL{
+		Host:   config.Host,
+		Path:   "/v1/messages",
+		Scheme: "https",
+	}).String()
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	return &url.URL{
+		User:     url.User(config.Token),
+		Host:     config.Host,
+		Scheme:   Scheme,
+		RawQuery: format.BuildQuery(resolver),
+	}
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	config.Token = url.User.Username()
+	config.Host = url.Host
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	if url.String() != "webex://dummy@dummy.com" {
+		return config.validate()
+	}
+
+	return nil
+}
+
+func (config *Config) validate() error {
+	if config.Token == "" {
+		return ErrorMissingToken
+	}
+
+	if config.Host == "" {
+		return ErrorMissingHost
+	}
+
+	if config.RoomID == "" && config.PersonEmail == "" {
+		return ErrorMissingTarget
+	}
+
+	if config.RoomID != "" && config.PersonEmail != "" {
+		return ErrorMultipleTargets
+	}
+
+	return nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "webex"
+)
@@ -0,0 +1,17 @@
+package webex
+
+import "errors"
+
+var (
+	// ErrorMissingToken is returned if the bot access token is missing from the service URL.
+	ErrorMissingToken = errors.New("missing bot access token")
+
+	// ErrorMissingHost is returned if the API hostname is missing from the service URL.
+	ErrorMissingHost = errors.New("missing Webex API host")
+
+	// ErrorMissingTarget is returned if neither a room ID nor a person e-mail has been specified.
+	ErrorMissingTarget = errors.New("either a room ID or a person e-mail is required")
+
+	// ErrorMultipleTargets is returned if both a room ID and a person e-mail have been specified.
+	ErrorMultipleTargets = errors.New("a room ID and a person e-mail cannot be used together")
+)
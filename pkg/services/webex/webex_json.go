@@ -0,0 +1,43 @@
+package webex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MessagePayload is the request body of the create message endpoint.
+//
+// See: https://developer.webex.com/docs/api/v1/messages/create-a-message
+type MessagePayload struct {
+	RoomID      string   `json:"roomId,omitempty"`
+	PersonEmail string   `json:"toPersonEmail,omitempty"`
+	ParentID    string   `json:"parentId,omitempty"`
+	Markdown    string   `json:"markdown"`
+	Files       []string `json:"files,omitempty"`
+}
+
+// errorResponse is the body of an API error response.
+type errorResponse struct {
+	Message    string `json:"message"`
+	TrackingID string `json:"trackingId"`
+	Errors     []struct {
+		Description string `json:"description"`
+	} `json:"errors"`
+}
+
+func (r *errorResponse) Error() string {
+	descriptions := make([]string, 0, len(r.Errors))
+
+	for _, apiError := range r.Errors {
+		if apiError.Description != "" && apiError.Description != r.Message {
+			descriptions = append(descriptions, apiError.Description)
+		}
+	}
+
+	msg := r.Message
+	if len(descriptions) > 0 {
+		msg += ": " + strings.Join(descriptions, ", ")
+	}
+
+	return fmt.Sprintf("api response: %v (tracking ID %v)", msg, r.TrackingID)
+}
@@ -0,0 +1,234 @@
+package webex
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/internal/testutils"
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+const (
+	mockToken  = "bottoken"
+	mockRoomID = "Y2lzY29zcGFyazovL3VzL1JPT00vYmJjZWIxYWQ"
+	mockAPIURL = "https://webexapis.com/v1/messages"
+)
+
+func TestWebex(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Shoutrrr Webex Suite")
+}
+
+var (
+	service *Service
+	logger  *log.Logger = testutils.TestLogger()
+)
+
+var _ = ginkgo.Describe("the Webex service", func() {
+	ginkgo.BeforeEach(func() {
+		service = &Service{}
+	})
+
+	ginkgo.Describe("the config", func() {
+		ginkgo.When("only required fields are set", func() {
+			ginkgo.It("should set the optional fields to the defaults", func() {
+				serviceURL := testutils.URLMust("webex://" + mockToken + "@webexapis.com?room=" + mockRoomID)
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				gomega.Expect(*service.Config).To(gomega.Equal(Config{
+					Token:      mockToken,
+					Host:       "webexapis.com",
+					RoomID:     mockRoomID,
+					MaxRetries: 3,
+				}))
+			})
+		})
+		ginkgo.When("the target is missing", func() {
+			ginkgo.It("should return an error", func() {
+				serviceURL := testutils.URLMust("webex://" + mockToken + "@webexapis.com")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.MatchError(ErrorMissingTarget))
+			})
+		})
+		ginkgo.When("both a room and a person are specified", func() {
+			ginkgo.It("should return an error", func() {
+				serviceURL := testutils.URLMust("webex://" + mockToken + "@webexapis.com?room=" + mockRoomID +
+					"&email=user@example.com")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.MatchError(ErrorMultipleTargets))
+			})
+		})
+		ginkgo.When("the token is missing", func() {
+			ginkgo.It("should return an error", func() {
+				serviceURL := testutils.URLMust("webex://webexapis.com?room=" + mockRoomID)
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.MatchError(ErrorMissingToken))
+			})
+		})
+		ginkgo.When("parsing the configuration URL", func() {
+			ginkgo.It("should be identical after de-/serialization", func() {
+				testURL := "webex://" + mockToken + "@localhost:8443" +
+					"?email=user%40example.com&file=https%3A%2F%2Fexample.com%2Freport.pdf&maxretries=5&parentid=parent&title=Alert"
+				config := &Config{}
+				pkr := format.NewPropKeyResolver(config)
+				gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+				gomega.Expect(config.setURL(&pkr, testutils.URLMust(testURL))).To(gomega.Succeed(), "verifying")
+				gomega.Expect(config.GetURL().String()).To(gomega.Equal(testURL))
+				gomega.Expect(config.GetAPIURL()).To(gomega.Equal("https://localhost:8443/v1/messages"))
+			})
+		})
+	})
+
+	ginkgo.Describe("sending messages", func() {
+		var (
+			requests    []map[string]any
+			authHeaders []string
+			delays      []time.Duration
+		)
+
+		ginkgo.BeforeEach(func() {
+			httpmock.Activate()
+
+			requests = nil
+			authHeaders = nil
+			delays = nil
+
+			serviceURL := testutils.URLMust("webex://" + mockToken + "@webexapis.com?room=" + mockRoomID)
+			gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+			service.sleep = func(delay time.Duration) {
+				delays = append(delays, delay)
+			}
+		})
+		ginkgo.AfterEach(func() {
+			httpmock.DeactivateAndReset()
+		})
+
+		// respondWith registers a responder that records the requests and returns the responses in order,
+		// repeating the last one.
+		respondWith := func(responses ...*http.Response) {
+			httpmock.RegisterResponder("POST", mockAPIURL, func(req *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(req.Body)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				request := map[string]any{}
+				gomega.Expect(json.Unmarshal(body, &request)).To(gomega.Succeed())
+
+				requests = append(requests, request)
+				authHeaders = append(authHeaders, req.Header.Get("Authorization"))
+
+				return responses[min(len(requests), len(responses))-1], nil
+			})
+		}
+
+		rateLimited := func(retryAfter string) *http.Response {
+			res := httpmock.NewStringResponse(http.StatusTooManyRequests, "")
+			if retryAfter != "" {
+				res.Header.Set("Retry-After", retryAfter)
+			}
+
+			return res
+		}
+
+		ginkgo.It("should post the markdown message to the room using the bot token", func() {
+			respondWith(httpmock.NewStringResponse(http.StatusOK, `{"id":"message"}`))
+			gomega.Expect(service.Send("Deploy **done**", nil)).To(gomega.Succeed())
+			gomega.Expect(requests).To(gomega.Equal([]map[string]any{{
+				"roomId":   mockRoomID,
+				"markdown": "Deploy **done**",
+			}}))
+			gomega.Expect(authHeaders).To(gomega.Equal([]string{"Bearer " + mockToken}))
+		})
+		ginkgo.It("should use the params for the title, person and file", func() {
+			respondWith(httpmock.NewStringResponse(http.StatusOK, `{"id":"message"}`))
+			gomega.Expect(service.Send("Deploy done", &types.Params{
+				"title": "CI",
+				"room":  "",
+				"email": "user@example.com",
+				"file":  "https://example.com/report.pdf",
+			})).To(gomega.Succeed())
+			gomega.Expect(requests).To(gomega.Equal([]map[string]any{{
+				"toPersonEmail": "user@example.com",
+				"markdown":      "**CI**\n\nDeploy done",
+				"files":         []any{"https://example.com/report.pdf"},
+			}}))
+			gomega.Expect(service.Config.PersonEmail).To(gomega.BeEmpty())
+		})
+		ginkgo.It("should retry rate limited requests after the Retry-After delay", func() {
+			respondWith(
+				rateLimited("2"),
+				rateLimited(""),
+				httpmock.NewStringResponse(http.StatusOK, `{"id":"message"}`),
+			)
+			gomega.Expect(service.Send("Deploy done", nil)).To(gomega.Succeed())
+			gomega.Expect(requests).To(gomega.HaveLen(3))
+			gomega.Expect(delays).To(gomega.Equal([]time.Duration{2 * time.Second, DefaultRetryDelay}))
+		})
+		ginkgo.It("should give up after the maximum number of retries", func() {
+			respondWith(rateLimited("1"))
+			gomega.Expect(service.Send("Deploy done", &types.Params{"maxretries": "1"})).
+				To(gomega.MatchError(gomega.ContainSubstring("429")))
+			gomega.Expect(requests).To(gomega.HaveLen(2))
+			gomega.Expect(delays).To(gomega.HaveLen(1))
+		})
+		ginkgo.It("should not wait for delays longer than the maximum", func() {
+			respondWith(rateLimited("3600"))
+			gomega.Expect(service.Send("Deploy done", nil)).To(gomega.MatchError(gomega.ContainSubstring("rate limited")))
+			gomega.Expect(requests).To(gomega.HaveLen(1))
+			gomega.Expect(delays).To(gomega.BeEmpty())
+		})
+		ginkgo.It("should return the errors reported by the API", func() {
+			respondWith(httpmock.NewStringResponse(http.StatusBadRequest, `{
+				"message": "Unable to post message to room",
+				"errors": [{"description": "The request could not be understood"}],
+				"trackingId": "ROUTER_1234"
+			}`))
+			gomega.Expect(service.Send("Deploy done", nil)).To(gomega.MatchError(
+				"failed to send webex notification: api response: Unable to post message to room: " +
+					"The request could not be understood (tracking ID ROUTER_1234)"))
+			gomega.Expect(delays).To(gomega.BeEmpty())
+		})
+	})
+
+	ginkgo.Describe("parsing the Retry-After header", func() {
+		now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		ginkgo.It("should accept a number of seconds", func() {
+			gomega.Expect(parseRetryAfter("30", now)).To(gomega.Equal(30 * time.Second))
+		})
+		ginkgo.It("should accept an HTTP date", func() {
+			gomega.Expect(parseRetryAfter("Mon, 01 Jan 2024 12:00:10 GMT", now)).To(gomega.Equal(10 * time.Second))
+			gomega.Expect(parseRetryAfter("Mon, 01 Jan 2024 11:00:00 GMT", now)).To(gomega.BeZero())
+		})
+		ginkgo.It("should use the default delay for invalid values", func() {
+			gomega.Expect(parseRetryAfter("soon", now)).To(gomega.Equal(DefaultRetryDelay))
+		})
+	})
+
+	ginkgo.Describe("the basic service API", func() {
+		ginkgo.Describe("the service config", func() {
+			ginkgo.It("should implement basic service config API methods correctly", func() {
+				testutils.TestConfigGetInvalidQueryValue(&Config{})
+				testutils.TestConfigSetInvalidQueryValue(&Config{}, "webex://token@host?room=id&foo=bar")
+				testutils.TestConfigSetDefaultValues(&Config{})
+				testutils.TestConfigGetEnumsCount(&Config{}, 0)
+				testutils.TestConfigGetFieldsCount(&Config{}, 8)
+			})
+		})
+		ginkgo.Describe("the service instance", func() {
+			ginkgo.It("should implement basic service API methods correctly", func() {
+				serviceURL := testutils.URLMust("webex://" + mockToken + "@host?room=" + mockRoomID)
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				testutils.TestServiceSetInvalidParamValue(service, "foo", "bar")
+			})
+		})
+	})
+
+	ginkgo.It("should return the correct service ID", func() {
+		gomega.Expect(service.GetID()).To(gomega.Equal("webex"))
+	})
+})
@@ -0,0 +1,117 @@
+// Package wecom implements WeCom (WeChat Work) group robots as a shoutrrr service.
+package wecom
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/services/standard"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util"
+	"github.com/nicholas-fedor/shoutrrr/pkg/util/jsonclient"
+)
+
+// Service sends notifications to a WeCom group using a group robot.
+type Service struct {
+	standard.Standard
+	Config *Config
+	pkr    format.PropKeyResolver
+}
+
+// Initialize loads ServiceConfig from configURL and sets logger for this Service.
+func (service *Service) Initialize(configURL *url.URL, logger types.StdLogger) error {
+	service.Logger.SetLogger(logger)
+	service.Config = &Config{}
+	service.pkr = format.NewPropKeyResolver(service.Config)
+
+	_ = service.pkr.SetDefaultProps(service.Config)
+
+	return service.Config.setURL(&service.pkr, configURL)
+}
+
+// GetID returns the service identifier.
+func (service *Service) GetID() string {
+	return Scheme
+}
+
+// GetConfigURLFromCustom creates a regular service URL from a native webhook URL,
+// e.g. wecom+https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=KEY.
+func (*Service) GetConfigURLFromCustom(customURL *url.URL) (*url.URL, error) {
+	key := customURL.Query().Get("key")
+	if customURL.Path != sendPath || key == "" {
+		return nil, fmt.Errorf("invalid webhook URL %q, expected %s?key=KEY", customURL.Path, sendPath)
+	}
+
+	config := &Config{}
+	pkr := format.NewPropKeyResolver(config)
+
+	if err := pkr.SetDefaultProps(config); err != nil {
+		return nil, err
+	}
+
+	config.Key = key
+	config.Host = customURL.Host
+
+	return config.getURL(&pkr), nil
+}
+
+// Send a notification message to the WeCom group of the group robot.
+// Unlike Lark and DingTalk, WeCom does not sign the webhook requests, the key itself is the only credential.
+func (service *Service) Send(message string, params *types.Params) error {
+	// Defensive copy, since the params only apply to this message
+	config := *service.Config
+
+	if err := service.pkr.UpdateConfigFromParams(&config, params); err != nil {
+		return err
+	}
+
+	res := response{}
+	jsonClient := jsonclient.NewClient()
+
+	if err := jsonClient.Post(config.WebhookURL().String(), createPayload(&config, message), &res); err != nil {
+		if jsonClient.ErrorResponse(err, &res) && res.ErrCode != 0 {
+			// response implements Error
+			return fmt.Errorf("failed to send wecom notification: %w", &res)
+		}
+
+		return fmt.Errorf("failed to send wecom notification: %w", err)
+	}
+
+	if res.ErrCode != 0 {
+		return fmt.Errorf("failed to send wecom notification: %w", &res)
+	}
+
+	return nil
+}
+
+func createPayload(config *Config, text string) message {
+	if config.MsgType == MsgTypeMarkdown {
+		if config.Title != "" {
+			text = fmt.Sprintf("**%s**\n%s", config.Title, text)
+		}
+
+		// Markdown messages only support mentions by user ID, using the <@userid> syntax
+		for _, userID := range util.NonEmpty(config.Mentions) {
+			text += fmt.Sprintf(" <@%s>", userID)
+		}
+
+		return message{
+			MsgType:  "markdown",
+			Markdown: &markdownContent{Content: text},
+		}
+	}
+
+	if config.Title != "" {
+		text = config.Title + "\n" + text
+	}
+
+	return message{
+		MsgType: "text",
+		Text: &textContent{
+			Content:             text,
+			MentionedList:       util.NonEmpty(config.Mentions),
+			MentionedMobileList: util.NonEmpty(config.Mobiles),
+		},
+	}
+}
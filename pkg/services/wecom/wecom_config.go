@@ -0,0 +1,89 @@
+package wecom
+
+import (
+	"net/url"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// sendPath is the path of the group robot webhooks, which take the webhook key as a query param.
+const sendPath = "/cgi-bin/webhook/send"
+
+// Config for the WeCom service.
+type Config struct {
+	Key      string   `desc:"Group robot webhook key"                                    required:""                                        secret:""          url:"user"`
+	Host     string   `default:"qyapi.weixin.qq.com"                                     desc:"Webhook host"                                url:"host"`
+	MsgType  msgType  `default:"Text"                                                    desc:"Send the messages as plain Text or Markdown" key:"msgtype,type"`
+	Title    string   `default:""                                                        desc:"Notification title, shown above the message" key:"title"`
+	Mentions []string `desc:"User IDs of the users to mention, or @all"                  key:"mentions"                                     optional:""`
+	Mobiles  []string `desc:"Phone numbers of the users to mention, or @all (Text only)" key:"mobiles"                                      optional:""`
+}
+
+// Enums returns the fields that should use a corresponding EnumFormatter to Print/Parse their values.
+func (config *Config) Enums() map[string]types.EnumFormatter {
+	return map[string]types.EnumFormatter{
+		"MsgType": MsgType.Enum,
+	}
+}
+
+// GetURL returns a URL representation of it's current field values.
+func (config *Config) GetURL() *url.URL {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.getURL(&resolver)
+}
+
+// SetURL updates a ServiceConfig from a URL representation of it's field values.
+func (config *Config) SetURL(url *url.URL) error {
+	resolver := format.NewPropKeyResolver(config)
+
+	return config.setURL(&resolver, url)
+}
+
+// WebhookURL returns the native group robot webhook URL for the config.
+func (config *Config) WebhookURL() *url.URL {
+	return &url.URL{
+		Scheme:   "https",
+		Host:     config.Host,
+		Path:     sendPath,
+		RawQuery: url.Values{"key": []string{config.Key}}.Encode(),
+	}
+}
+
+func (config *Config) getURL(resolver types.ConfigQueryResolver) *url.URL {
+	return &url.URL{
+		User:     url.User(config.Key),
+		Host:     config.Host,
+		Scheme:   Scheme,
+		RawQuery: format.BuildQuery(resolver),
+	}
+}
+
+func (config *Config) setURL(resolver types.ConfigQueryResolver, url *url.URL) error {
+	config.Key = url.User.Username()
+	config.Host = url.Host
+
+	for key, vals := range url.Query() {
+		if err := resolver.Set(key, vals[0]); err != nil {
+			return err
+		}
+	}
+
+	if url.String() != "wecom://dummy@dummy.com" {
+		if config.Key == "" {
+			return ErrorMissingKey
+		}
+
+		if config.Host == "" {
+			return ErrorMissingHost
+		}
+	}
+
+	return nil
+}
+
+const (
+	// Scheme is the identifying part of this service's configuration URL.
+	Scheme = "wecom"
+)
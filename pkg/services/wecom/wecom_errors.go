@@ -0,0 +1,11 @@
+package wecom
+
+import "errors"
+
+var (
+	// ErrorMissingKey is returned if the webhook key is missing from the service URL.
+	ErrorMissingKey = errors.New("missing webhook key")
+
+	// ErrorMissingHost is returned if the webhook host is missing from the service URL.
+	ErrorMissingHost = errors.New("missing webhook host")
+)
@@ -0,0 +1,32 @@
+package wecom
+
+import "fmt"
+
+// message is the request body posted to the group robot webhook.
+//
+// See: https://developer.work.weixin.qq.com/document/path/91770
+type message struct {
+	MsgType  string           `json:"msgtype"`
+	Text     *textContent     `json:"text,omitempty"`
+	Markdown *markdownContent `json:"markdown,omitempty"`
+}
+
+type textContent struct {
+	Content             string   `json:"content"`
+	MentionedList       []string `json:"mentioned_list,omitempty"`
+	MentionedMobileList []string `json:"mentioned_mobile_list,omitempty"`
+}
+
+type markdownContent struct {
+	Content string `json:"content"`
+}
+
+// response is the body returned by the group robot webhook.
+type response struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (r *response) Error() string {
+	return fmt.Sprintf("api response: %v (errcode %v)", r.ErrMsg, r.ErrCode)
+}
@@ -0,0 +1,161 @@
+package wecom
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/internal/testutils"
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+const (
+	mockKey        = "693a91f6-7xxx-4bc4-97a0-0ec2sifa5aaa"
+	mockWebhookURL = "https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=" + mockKey
+)
+
+func TestWeCom(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Shoutrrr WeCom Suite")
+}
+
+var (
+	service *Service
+	logger  *log.Logger = testutils.TestLogger()
+)
+
+var _ = ginkgo.Describe("the WeCom service", func() {
+	ginkgo.BeforeEach(func() {
+		service = &Service{}
+	})
+
+	ginkgo.Describe("the config", func() {
+		ginkgo.When("only required fields are set", func() {
+			ginkgo.It("should set the optional fields to the defaults", func() {
+				serviceURL := testutils.URLMust("wecom://" + mockKey + "@qyapi.weixin.qq.com")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				gomega.Expect(*service.Config).To(gomega.Equal(Config{
+					Key:      mockKey,
+					Host:     "qyapi.weixin.qq.com",
+					MsgType:  MsgTypeText,
+					Mentions: []string{""},
+					Mobiles:  []string{""},
+				}))
+				gomega.Expect(service.Config.WebhookURL().String()).To(gomega.Equal(mockWebhookURL))
+			})
+		})
+		ginkgo.When("the key is missing", func() {
+			ginkgo.It("should return an error", func() {
+				serviceURL := testutils.URLMust("wecom://qyapi.weixin.qq.com")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.MatchError(ErrorMissingKey))
+			})
+		})
+		ginkgo.When("parsing the configuration URL", func() {
+			ginkgo.It("should be identical after de-/serialization", func() {
+				testURL := "wecom://" + mockKey + "@qyapi.weixin.qq.com" +
+					"?mentions=wangqing%2C%40all&mobiles=13800001111&msgtype=Markdown&title=Alert"
+				config := &Config{}
+				pkr := format.NewPropKeyResolver(config)
+				gomega.Expect(pkr.SetDefaultProps(config)).To(gomega.Succeed())
+				gomega.Expect(config.setURL(&pkr, testutils.URLMust(testURL))).To(gomega.Succeed(), "verifying")
+				gomega.Expect(config.GetURL().String()).To(gomega.Equal(testURL))
+			})
+		})
+		ginkgo.When("converting a native webhook URL", func() {
+			ginkgo.It("should use the key and host of the webhook", func() {
+				serviceURL, err := service.GetConfigURLFromCustom(testutils.URLMust("wecom+" + mockWebhookURL))
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(serviceURL.String()).To(gomega.Equal("wecom://" + mockKey + "@qyapi.weixin.qq.com"))
+			})
+			ginkgo.It("should return an error if the key is missing", func() {
+				_, err := service.GetConfigURLFromCustom(
+					testutils.URLMust("wecom+https://qyapi.weixin.qq.com/cgi-bin/webhook/send"))
+				gomega.Expect(err).To(gomega.HaveOccurred())
+			})
+		})
+	})
+
+	ginkgo.Describe("sending messages", func() {
+		var requestBody map[string]any
+
+		ginkgo.BeforeEach(func() {
+			httpmock.Activate()
+
+			requestBody = nil
+			httpmock.RegisterResponder("POST", mockWebhookURL, func(req *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(req.Body)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(json.Unmarshal(body, &requestBody)).To(gomega.Succeed())
+
+				return httpmock.NewStringResponse(http.StatusOK, `{"errcode":0,"errmsg":"ok"}`), nil
+			})
+
+			serviceURL := testutils.URLMust("wecom://" + mockKey + "@qyapi.weixin.qq.com" +
+				"?mentions=wangqing&mobiles=13800001111")
+			gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+		})
+		ginkgo.AfterEach(func() {
+			httpmock.DeactivateAndReset()
+		})
+
+		ginkgo.It("should send a text message with the mentions", func() {
+			gomega.Expect(service.Send("Deploy done", &types.Params{"title": "CI"})).To(gomega.Succeed())
+			gomega.Expect(requestBody).To(gomega.Equal(map[string]any{
+				"msgtype": "text",
+				"text": map[string]any{
+					"content":               "CI\nDeploy done",
+					"mentioned_list":        []any{"wangqing"},
+					"mentioned_mobile_list": []any{"13800001111"},
+				},
+			}))
+		})
+		ginkgo.It("should send a markdown message with the mentions in the content", func() {
+			gomega.Expect(service.Send("Deploy <font color=\"info\">done</font>", &types.Params{
+				"title": "CI",
+				"type":  "markdown",
+			})).To(gomega.Succeed())
+			gomega.Expect(requestBody).To(gomega.Equal(map[string]any{
+				"msgtype": "markdown",
+				"markdown": map[string]any{
+					"content": "**CI**\nDeploy <font color=\"info\">done</font> <@wangqing>",
+				},
+			}))
+		})
+		ginkgo.It("should return the errors reported by the webhook", func() {
+			httpmock.RegisterResponder("POST", mockWebhookURL, httpmock.NewStringResponder(http.StatusOK,
+				`{"errcode":93000,"errmsg":"invalid webhook url"}`))
+			gomega.Expect(service.Send("Deploy done", nil)).To(gomega.MatchError(
+				"failed to send wecom notification: api response: invalid webhook url (errcode 93000)"))
+		})
+	})
+
+	ginkgo.Describe("the basic service API", func() {
+		ginkgo.Describe("the service config", func() {
+			ginkgo.It("should implement basic service config API methods correctly", func() {
+				testutils.TestConfigGetInvalidQueryValue(&Config{})
+				testutils.TestConfigSetInvalidQueryValue(&Config{}, "wecom://key@host?foo=bar")
+				testutils.TestConfigSetDefaultValues(&Config{})
+				testutils.TestConfigGetEnumsCount(&Config{}, 1)
+				testutils.TestConfigGetFieldsCount(&Config{}, 5)
+			})
+		})
+		ginkgo.Describe("the service instance", func() {
+			ginkgo.It("should implement basic service API methods correctly", func() {
+				serviceURL := testutils.URLMust("wecom://" + mockKey + "@host")
+				gomega.Expect(service.Initialize(serviceURL, logger)).To(gomega.Succeed())
+				testutils.TestServiceSetInvalidParamValue(service, "foo", "bar")
+			})
+		})
+	})
+
+	ginkgo.It("should return the correct service ID", func() {
+		gomega.Expect(service.GetID()).To(gomega.Equal("wecom"))
+	})
+})
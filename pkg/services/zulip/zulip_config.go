@@ -13,7 +13,7 @@ import (
 type Config struct {
 	standard.EnumlessConfig
 	BotMail string `desc:"Bot e-mail address"        url:"user"`
-	BotKey  string `desc:"API Key"                   url:"pass"`
+	BotKey  string `desc:"API Key"                   secret:""         url:"pass"`
 	Host    string `desc:"API server hostname"       url:"host,port"`
 	Stream  string `description:"Target stream name" key:"stream"      optional:""`
 	Topic   string `default:""                       key:"topic,title"`
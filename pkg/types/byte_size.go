@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ByteSize is a size in bytes, that is represented using decimal (KB, MB, GB, TB) or binary
+// (KiB, MiB, GiB, TiB) units, e.g. "512KiB" or "10MB".
+type ByteSize uint64
+
+// Byte size units.
+const (
+	Byte     ByteSize = 1
+	Kilobyte ByteSize = 1000 * Byte
+	Megabyte ByteSize = 1000 * Kilobyte
+	Gigabyte ByteSize = 1000 * Megabyte
+	Terabyte ByteSize = 1000 * Gigabyte
+	Kibibyte ByteSize = 1024 * Byte
+	Mebibyte ByteSize = 1024 * Kibibyte
+	Gibibyte ByteSize = 1024 * Mebibyte
+	Tebibyte ByteSize = 1024 * Gibibyte
+)
+
+type byteSizeUnit struct {
+	name string
+	size ByteSize
+}
+
+// byteSizeUnits are the units used by ByteSize, ordered from largest to smallest.
+var byteSizeUnits = []byteSizeUnit{
+	{"TiB", Tebibyte},
+	{"TB", Terabyte},
+	{"GiB", Gibibyte},
+	{"GB", Gigabyte},
+	{"MiB", Mebibyte},
+	{"MB", Megabyte},
+	{"KiB", Kibibyte},
+	{"KB", Kilobyte},
+	{"B", Byte},
+}
+
+// String returns the size using the largest unit that can represent it exactly, e.g. "1MiB" for 1048576.
+func (bs ByteSize) String() string {
+	for _, unit := range byteSizeUnits {
+		if bs != 0 && bs%unit.size == 0 {
+			return strconv.FormatUint(uint64(bs/unit.size), 10) + unit.name
+		}
+	}
+
+	return "0B"
+}
+
+// ParseByteSize parses a size with an optional (case-insensitive) unit, like "512", "1.5 KiB" or "10mb".
+// Sizes without a unit are in bytes. An empty string is parsed as 0. Sizes that are not a whole number of bytes,
+// like "1.5B", or that do not fit in a ByteSize are rejected.
+func ParseByteSize(value string) (ByteSize, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+
+	numberEnd := strings.IndexFunc(value, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if numberEnd < 0 {
+		numberEnd = len(value)
+	}
+
+	// The number is parsed as a fraction, so that the size in bytes can be checked without rounding errors
+	number, valid := new(big.Rat).SetString(value[:numberEnd])
+	if !valid || numberEnd == 0 {
+		return 0, fmt.Errorf("invalid byte size %q", value)
+	}
+
+	unitName := strings.TrimSpace(value[numberEnd:])
+	if unitName == "" {
+		unitName = "B"
+	}
+
+	for _, unit := range byteSizeUnits {
+		if strings.EqualFold(unit.name, unitName) {
+			size := number.Mul(number, new(big.Rat).SetUint64(uint64(unit.size)))
+			if !size.IsInt() {
+				return 0, fmt.Errorf("invalid byte size %q, it is not a whole number of bytes", value)
+			}
+
+			if !size.Num().IsUint64() {
+				return 0, fmt.Errorf("invalid byte size %q, it is larger than %d bytes", value, uint64(math.MaxUint64))
+			}
+
+			return ByteSize(size.Num().Uint64()), nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid byte size unit %q", unitName)
+}
@@ -0,0 +1,12 @@
+package types
+
+// AnyParamKey is returned by ExtraParamsService.ExtraParamKeys for services that accept params with any key.
+const AnyParamKey = "*"
+
+// ExtraParamsService is the interface implemented by services that use params that are not config props, like
+// params that are added to the payload.
+type ExtraParamsService interface {
+	// ExtraParamKeys returns the keys of the params used in addition to the config props, the title and the
+	// message, or AnyParamKey if all params are used.
+	ExtraParamKeys() []string
+}
@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+// VerifyTimeout is the maximum time that the requests made by online verification are allowed to take, since
+// unresponsive hosts would otherwise keep the verification from ever completing.
+const VerifyTimeout = 10 * time.Second
+
+// Verifier is the interface implemented by services that can check their configuration against the
+// remote API, like validating credentials and targets, without sending a notification.
+type Verifier interface {
+	Verify() error
+}
@@ -0,0 +1,9 @@
+package types
+
+import "net/url"
+
+// WebhookConfig is the interface implemented by service configs that can be represented as a native webhook URL.
+type WebhookConfig interface {
+	ServiceConfig
+	WebhookURL() *url.URL
+}
@@ -23,5 +23,19 @@ func Max(a int, b int) int {
 	return b
 }
 
+// NonEmpty returns the items that are not empty, since list values without any items are parsed as a single
+// empty item.
+func NonEmpty(items []string) []string {
+	nonEmpty := make([]string, 0, len(items))
+
+	for _, item := range items {
+		if item != "" {
+			nonEmpty = append(nonEmpty, item)
+		}
+	}
+
+	return nonEmpty
+}
+
 // DiscardLogger is a logger that discards any output written to it.
 var DiscardLogger = log.New(io.Discard, "", 0)
@@ -37,6 +37,13 @@ var _ = ginkgo.Describe("the util package", func() {
 		})
 	})
 
+	ginkgo.When("calling function NonEmpty", func() {
+		ginkgo.It("should return the items that are not empty", func() {
+			gomega.Expect(util.NonEmpty([]string{"", "a", "", "b"})).To(gomega.Equal([]string{"a", "b"}))
+			gomega.Expect(util.NonEmpty([]string{""})).To(gomega.BeEmpty())
+		})
+	})
+
 	ginkgo.When("checking if a supplied kind is of the signed integer kind", func() {
 		ginkgo.It("should be true if the kind is Int", func() {
 			gomega.Expect(util.IsSignedInt(reflect.Int)).To(gomega.BeTrue())
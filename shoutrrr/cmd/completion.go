@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/router"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// completionDirective is used for all service URL and param completions, since they are never file names,
+// and a completed scheme, key or value is usually followed by more input.
+const completionDirective = cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+
+// RegisterURLCompletion adds dynamic shell completion of service URLs to the flag of the command.
+func RegisterURLCompletion(cobraCmd *cobra.Command, flagName string) {
+	_ = cobraCmd.RegisterFlagCompletionFunc(flagName, CompleteServiceURL)
+}
+
+// RegisterParamCompletion adds dynamic shell completion of key=value params to the flag of the command, using
+// the query/param props of the services in the URLs of the urlFlagName flag.
+func RegisterParamCompletion(cobraCmd *cobra.Command, flagName string, urlFlagName string) {
+	_ = cobraCmd.RegisterFlagCompletionFunc(flagName, func(
+		cobraCmd *cobra.Command,
+		_ []string,
+		toComplete string,
+	) ([]string, cobra.ShellCompDirective) {
+		return CompleteParam(getFlagValues(cobraCmd, urlFlagName), toComplete), completionDirective
+	})
+}
+
+// CompleteServiceURL completes the service scheme of a URL, and once the query has been started, the keys of
+// the service query props followed by their known values (enum names, booleans and oneof values).
+func CompleteServiceURL(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	scheme, rest, hasScheme := strings.Cut(toComplete, "://")
+	if !hasScheme {
+		return completeSchemes(toComplete), completionDirective
+	}
+
+	base, query, hasQuery := strings.Cut(toComplete, "?")
+	if !hasQuery {
+		// The host and path parts are specific to each service
+		return nil, completionDirective
+	}
+
+	config := getServiceConfig(strings.SplitN(scheme, "+", 2)[0])
+	if config == nil || rest == "" {
+		return nil, completionDirective
+	}
+
+	// Only the last query prop is completed, the preceding ones are kept as is
+	prefix := base + "?"
+	existingKeys := []string{}
+	pairs := strings.Split(query, "&")
+
+	for _, pair := range pairs[:len(pairs)-1] {
+		prefix += pair + "&"
+		key, _, _ := strings.Cut(pair, "=")
+		existingKeys = append(existingKeys, key)
+	}
+
+	key, value, hasValue := strings.Cut(pairs[len(pairs)-1], "=")
+	completions := []string{}
+
+	if hasValue {
+		for _, completion := range format.GetPropValueCompletions(config, key, value) {
+			completions = append(completions, prefix+key+"="+completion)
+		}
+	} else {
+		for _, completion := range format.GetPropKeyCompletions(config, key, existingKeys) {
+			completions = append(completions, prefix+completion+"=")
+		}
+	}
+
+	return completions, completionDirective
+}
+
+// CompleteParam completes the key of a key=value param using the query/param props of the services in the
+// URLs (and the common title and message keys), and once the key is complete, the known values of the prop.
+func CompleteParam(serviceURLs []string, toComplete string) []string {
+	configs := []types.ServiceConfig{}
+
+	for _, serviceURL := range serviceURLs {
+		scheme, _, _ := strings.Cut(serviceURL, "://")
+		if config := getServiceConfig(strings.SplitN(scheme, "+", 2)[0]); config != nil {
+			configs = append(configs, config)
+		}
+	}
+
+	completions := []string{}
+	seen := map[string]bool{}
+	add := func(completion string) {
+		if !seen[completion] {
+			seen[completion] = true
+			completions = append(completions, completion)
+		}
+	}
+
+	key, value, hasValue := strings.Cut(toComplete, "=")
+	if hasValue {
+		for _, config := range configs {
+			for _, completion := range format.GetPropValueCompletions(config, key, value) {
+				add(key + "=" + completion)
+			}
+		}
+
+		return completions
+	}
+
+	for _, commonKey := range []string{types.TitleKey, types.MessageKey} {
+		if strings.HasPrefix(commonKey, strings.ToLower(key)) {
+			add(commonKey + "=")
+		}
+	}
+
+	for _, config := range configs {
+		for _, completion := range format.GetPropKeyCompletions(config, key, nil) {
+			add(completion + "=")
+		}
+	}
+
+	return completions
+}
+
+func completeSchemes(prefix string) []string {
+	completions := []string{}
+
+	for _, scheme := range (&router.ServiceRouter{}).ListServices() {
+		if strings.HasPrefix(scheme, strings.ToLower(prefix)) {
+			completions = append(completions, scheme+"://")
+		}
+	}
+
+	return completions
+}
+
+// getServiceConfig returns a new config for the service with the scheme, or nil if there is no such service.
+func getServiceConfig(scheme string) types.ServiceConfig {
+	service, err := (&router.ServiceRouter{}).NewService(scheme)
+	if err != nil {
+		return nil
+	}
+
+	return format.GetServiceConfig(service)
+}
+
+// getFlagValues returns the values of a string or string array flag.
+func getFlagValues(cobraCmd *cobra.Command, flagName string) []string {
+	flag := cobraCmd.Flags().Lookup(flagName)
+	if flag == nil {
+		return nil
+	}
+
+	if sliceValue, isSlice := flag.Value.(pflag.SliceValue); isSlice {
+		return sliceValue.GetSlice()
+	}
+
+	if value := flag.Value.String(); value != "" {
+		return []string{value}
+	}
+
+	return nil
+}
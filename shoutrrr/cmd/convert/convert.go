@@ -0,0 +1,70 @@
+package convert
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/router"
+	"github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
+)
+
+// Cmd converts native webhook URLs into service URLs and back.
+var Cmd = &cobra.Command{
+	Use:   "convert <URL>",
+	Short: "Convert a native webhook URL into a notification service URL, or the reverse",
+	Long: `Convert a native webhook URL into a notification service URL, or the reverse.
+
+If the URL uses the http or https scheme, it is treated as a native webhook URL and the service is
+detected from the host, unless specified using --service. Otherwise, the URL is treated as a
+notification service URL and converted into the native webhook URL for that service.`,
+	Run:  Run,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	Cmd.Flags().StringP("service", "s", "", "The service to use for the webhook URL, instead of detecting it")
+}
+
+// Run the convert command.
+func Run(cobraCmd *cobra.Command, args []string) {
+	service, _ := cobraCmd.Flags().GetString("service")
+
+	converted, res := convertURL(args[0], service)
+	if res.ExitCode != 0 {
+		_, _ = fmt.Fprintln(os.Stderr, res.Message)
+		os.Exit(res.ExitCode)
+	}
+
+	fmt.Println(converted)
+}
+
+func convertURL(rawURL string, service string) (string, cmd.Result) {
+	var converted *url.URL
+
+	var err error
+
+	if isWebhookURL(rawURL) {
+		converted, err = router.ConvertFromWebhookURL(rawURL, service)
+	} else if service != "" {
+		return "", cmd.InvalidUsage("the service flag can only be used with webhook URLs")
+	} else {
+		converted, err = router.ConvertToWebhookURL(rawURL)
+	}
+
+	if err != nil {
+		return "", cmd.ConfigurationError(fmt.Sprintf("failed to convert URL: %v", err))
+	}
+
+	return converted.String(), cmd.Success
+}
+
+func isWebhookURL(rawURL string) bool {
+	scheme, _, _ := strings.Cut(rawURL, "://")
+	scheme = strings.ToLower(scheme)
+
+	return scheme == "http" || scheme == "https"
+}
@@ -0,0 +1,179 @@
+package diff
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/router"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	"github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
+)
+
+// ExDifferent is the exit code that signals that the URLs are not equivalent.
+const ExDifferent = 1
+
+// ExpectedNArgs is the number of URLs that are compared.
+const ExpectedNArgs = 2
+
+// Cmd shows the field differences between two service URLs.
+var Cmd = &cobra.Command{
+	Use:   "diff <URL> <URL>",
+	Short: "Show the config field differences between two notification service URLs",
+	Long: `Show the config field differences between two notification service URLs.
+
+Both URLs are parsed using the service config, so differences in query order, prop key aliases and
+default values are ignored. Differences in the scheme and in the parts of the normalized URLs that are not
+config fields are reported as well. The exit code is 0 if the URLs are equivalent and 1 if they are not.`,
+	Run:  Run,
+	Args: cobra.ExactArgs(ExpectedNArgs),
+	ValidArgsFunction: func(cobraCmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= ExpectedNArgs {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return cmd.CompleteServiceURL(cobraCmd, args, toComplete)
+	},
+}
+
+func init() {
+	Cmd.Flags().BoolP("show-sensitive", "x", false, "Show the values of secret fields (default: masked)")
+	cmd.AddOutputFlag(Cmd)
+}
+
+// diffResult is the JSON output of the diff command.
+type diffResult struct {
+	Equal       bool        `json:"equal"`
+	Left        string      `json:"left"`
+	Right       string      `json:"right"`
+	Differences []fieldDiff `json:"differences"`
+}
+
+// fieldDiff is the JSON representation of a field with different values.
+type fieldDiff struct {
+	Field string `json:"field"`
+	Left  string `json:"left"`
+	Right string `json:"right"`
+}
+
+// Run the diff command.
+func Run(cobraCmd *cobra.Command, args []string) {
+	showSensitive, _ := cobraCmd.Flags().GetBool("show-sensitive")
+
+	output, err := cmd.GetOutputFormat(cobraCmd)
+	if err != nil {
+		exit(cmd.InvalidUsage(err.Error()))
+	}
+
+	result, res := diffURLs(args[0], args[1], showSensitive)
+	if res.ExitCode != 0 {
+		exit(res)
+	}
+
+	if output == cmd.OutputJSON {
+		_ = cmd.WriteJSON(os.Stdout, result)
+	} else {
+		printResult(result)
+	}
+
+	if !result.Equal {
+		os.Exit(ExDifferent)
+	}
+}
+
+func exit(res cmd.Result) {
+	_, _ = fmt.Fprintln(os.Stderr, res.Message)
+	os.Exit(res.ExitCode)
+}
+
+func diffURLs(leftURL string, rightURL string, showSensitive bool) (diffResult, cmd.Result) {
+	serviceRouter := router.ServiceRouter{}
+
+	left, err := serviceRouter.ParseConfig(leftURL)
+	if err != nil {
+		return diffResult{}, cmd.ConfigurationError(fmt.Sprintf("invalid first URL: %v", err))
+	}
+
+	right, err := serviceRouter.ParseConfig(rightURL)
+	if err != nil {
+		return diffResult{}, cmd.ConfigurationError(fmt.Sprintf("invalid second URL: %v", err))
+	}
+
+	if reflect.TypeOf(left) != reflect.TypeOf(right) {
+		return diffResult{}, cmd.ConfigurationError("the URLs are for different services")
+	}
+
+	result := diffResult{
+		Left:        getDisplayURL(left, showSensitive),
+		Right:       getDisplayURL(right, showSensitive),
+		Differences: []fieldDiff{},
+	}
+
+	for _, diff := range format.DiffConfigs(left, right) {
+		leftValue, rightValue := diff.Left, diff.Right
+
+		switch {
+		case diff.Field == format.URLDiffField:
+			// The URLs only differ in values that are not config fields, which are shown in the display URLs
+			leftValue, rightValue = result.Left, result.Right
+		case diff.Field.Secret && !showSensitive:
+			leftValue, rightValue = maskValue(leftValue), maskValue(rightValue)
+		}
+
+		result.Differences = append(result.Differences, fieldDiff{
+			Field: diff.Field.Name,
+			Left:  leftValue,
+			Right: rightValue,
+		})
+	}
+
+	result.Equal = len(result.Differences) == 0
+
+	return result, cmd.Success
+}
+
+func getDisplayURL(config types.ServiceConfig, showSensitive bool) string {
+	if showSensitive {
+		return config.GetURL().String()
+	}
+
+	return format.RedactConfigURL(config)
+}
+
+func maskValue(value string) string {
+	if value == "" {
+		return value
+	}
+
+	return format.Redacted
+}
+
+func printResult(result diffResult) {
+	if result.Equal {
+		_, _ = fmt.Fprintln(color.Output, color.GreenString("The URLs are equivalent"))
+
+		return
+	}
+
+	_, _ = fmt.Fprintln(color.Output, color.RedString("- %s", result.Left))
+	_, _ = fmt.Fprintln(color.Output, color.GreenString("+ %s", result.Right))
+	_, _ = fmt.Fprintln(color.Output)
+
+	for _, diff := range result.Differences {
+		_, _ = fmt.Fprintln(color.Output, color.CyanString(diff.Field))
+		_, _ = fmt.Fprintln(color.Output, color.RedString("  - %s", displayValue(diff.Left)))
+		_, _ = fmt.Fprintln(color.Output, color.GreenString("  + %s", displayValue(diff.Right)))
+	}
+}
+
+func displayValue(value string) string {
+	if value == "" {
+		return "(empty)"
+	}
+
+	return value
+}
@@ -5,15 +5,20 @@ import (
 	"log"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/nicholas-fedor/shoutrrr/pkg/router"
 	"github.com/spf13/cobra"
 
 	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
 	"github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
 )
 
+// docsFileMode is the file mode used for documentation files written to a directory.
+const docsFileMode = 0o644
+
 var (
 	serviceRouter router.ServiceRouter
 	services      = serviceRouter.ListServices()
@@ -33,12 +38,25 @@ var Cmd = &cobra.Command{
 }
 
 func init() {
-	Cmd.Flags().StringP("format", "f", "console", "Output format")
+	Cmd.Flags().StringP("format", "f", "console", "Output format, either console, markdown, html, man or jsonschema")
+	Cmd.Flags().StringP("dir", "d", "", "Write the documentation for each service to a separate file in the directory")
+	cmd.AddOutputFlag(Cmd)
 }
 
-func Run(cmd *cobra.Command, args []string) {
-	format, _ := cmd.Flags().GetString("format")
-	res := printDocs(format, args)
+func Run(cobraCmd *cobra.Command, args []string) {
+	format, _ := cobraCmd.Flags().GetString("format")
+	dir, _ := cobraCmd.Flags().GetString("dir")
+
+	res := cmd.Success
+
+	output, err := cmd.GetOutputFormat(cobraCmd)
+	if err != nil {
+		res = cmd.InvalidUsage(err.Error())
+	} else if output == cmd.OutputJSON {
+		res = printJSONDocs(args)
+	} else {
+		res = printDocs(format, args, dir)
+	}
 
 	if res.ExitCode != 0 {
 		_, _ = fmt.Fprintf(os.Stderr, "%s", res.Message)
@@ -47,39 +65,150 @@ func Run(cmd *cobra.Command, args []string) {
 	os.Exit(res.ExitCode)
 }
 
-func printDocs(docFormat string, services []string) cmd.Result {
-	var renderer format.TreeRenderer
+// propsDescription is the description of the query/param props used by the html and man formats.
+const propsDescription = "Props can be either supplied using the params argument, or through the URL using " +
+	"?key=value&key=value etc."
 
+// propsEmptyMessage is used by the html and man formats for services without any query/param props.
+const propsEmptyMessage = "The service does not support any query/param props."
+
+// formatJSONSchema is the name of the JSON Schema documentation format.
+const formatJSONSchema = "jsonschema"
+
+// getRenderer returns the renderer for the documentation format, and the file name extension used for it.
+func getRenderer(docFormat string) (format.TreeRenderer, string, bool) {
 	switch docFormat {
 	case "console":
-		renderer = format.ConsoleTreeRenderer{WithValues: false}
+		return format.ConsoleTreeRenderer{WithValues: false}, ".txt", true
 	case "markdown":
-		renderer = format.MarkdownTreeRenderer{
+		return format.MarkdownTreeRenderer{
 			HeaderPrefix:      "### ",
 			PropsDescription:  "Props can be either supplied using the params argument, or through the URL using  \n`?key=value&key=value` etc.\n",
 			PropsEmptyMessage: "*The services does not support any query/param props*",
-		}
-	default:
+		}, ".md", true
+	case "html":
+		return format.HTMLTreeRenderer{
+			PropsDescription:  propsDescription,
+			PropsEmptyMessage: propsEmptyMessage,
+		}, ".html", true
+	case "man":
+		return format.ManTreeRenderer{
+			PropsDescription:  propsDescription,
+			PropsEmptyMessage: propsEmptyMessage,
+		}, "." + format.DefaultManSection, true
+	case formatJSONSchema:
+		return format.JSONSchemaTreeRenderer{}, ".schema.json", true
+	}
+
+	return nil, "", false
+}
+
+func printDocs(docFormat string, services []string, dir string) cmd.Result {
+	renderer, extension, ok := getRenderer(docFormat)
+	if !ok {
 		return cmd.InvalidUsage("invalid format")
 	}
 
 	logger := log.New(os.Stderr, "", 0) // Concrete logger implementing types.StdLogger
 
+	if docFormat == formatJSONSchema && dir == "" && len(services) > 1 {
+		return printJSONSchemas(services, logger)
+	}
+
 	for _, scheme := range services {
-		service, err := serviceRouter.NewService(scheme)
-		if err != nil {
-			return cmd.InvalidUsage("failed to init service: " + err.Error())
+		configNode, res := getServiceConfigNode(scheme, logger)
+		if res != cmd.Success {
+			return res
 		}
-		// Initialize the service to populate Config
-		dummyURL, _ := url.Parse(fmt.Sprintf("%s://dummy@dummy.com", scheme))
-		if err := service.Initialize(dummyURL, logger); err != nil {
-			return cmd.InvalidUsage(fmt.Sprintf("failed to initialize service %q: %v", scheme, err))
+
+		docs := renderer.RenderTree(configNode, scheme)
+
+		if dir == "" {
+			fmt.Println(docs)
+
+			continue
 		}
 
-		config := format.GetServiceConfig(service)
-		configNode := format.GetConfigFormat(config)
-		fmt.Println(renderer.RenderTree(configNode, scheme))
+		// The man page name is used for all formats, so that the files match the shoutrrr-<scheme> man pages
+		fileName := filepath.Join(dir, "shoutrrr-"+scheme+extension)
+		if err := os.WriteFile(fileName, []byte(docs), docsFileMode); err != nil {
+			return cmd.TaskUnavailable("failed to write documentation: " + err.Error())
+		}
 	}
 
 	return cmd.Success
 }
+
+// printJSONSchemas prints the JSON Schemas for all the services as a single JSON object, keyed by the service scheme.
+func printJSONSchemas(services []string, logger *log.Logger) cmd.Result {
+	renderer := format.JSONSchemaTreeRenderer{}
+	schemas := make(map[string]*format.JSONSchema, len(services))
+
+	for _, scheme := range services {
+		configNode, res := getServiceConfigNode(scheme, logger)
+		if res != cmd.Success {
+			return res
+		}
+
+		schemas[scheme] = renderer.Schema(configNode, scheme)
+	}
+
+	if err := cmd.WriteJSON(os.Stdout, schemas); err != nil {
+		return cmd.TaskUnavailable("failed to write output: " + err.Error())
+	}
+
+	return cmd.Success
+}
+
+// printJSONDocs prints the documentation for all the services as a single JSON array.
+func printJSONDocs(services []string) cmd.Result {
+	renderer := format.JSONTreeRenderer{WithValues: false}
+	logger := log.New(os.Stderr, "", 0)
+	docs := make([]format.JSONServiceConfig, 0, len(services))
+
+	for _, scheme := range services {
+		configNode, res := getServiceConfigNode(scheme, logger)
+		if res != cmd.Success {
+			return res
+		}
+
+		docs = append(docs, renderer.ServiceConfig(configNode, scheme))
+	}
+
+	if err := cmd.WriteJSON(os.Stdout, docs); err != nil {
+		return cmd.TaskUnavailable("failed to write output: " + err.Error())
+	}
+
+	return cmd.Success
+}
+
+// getServiceConfigNode returns the config node tree of a dummy initialized instance of the service.
+// The config of an uninitialized instance is used for services that cannot be initialized from the
+// dummy URL, instead of failing.
+func getServiceConfigNode(scheme string, logger *log.Logger) (*format.ContainerNode, cmd.Result) {
+	service, err := serviceRouter.NewService(scheme)
+	if err != nil {
+		return nil, cmd.InvalidUsage("failed to init service: " + err.Error())
+	}
+
+	if err := initDummyService(service, scheme, logger); err != nil {
+		// Services that require more than the dummy URL still have the same config fields, so use an
+		// uninitialized config, since only the field info and default values are documented
+		logger.Printf("failed to initialize service %q, using the default config: %v", scheme, err)
+
+		if service, err = serviceRouter.NewService(scheme); err != nil {
+			return nil, cmd.InvalidUsage("failed to init service: " + err.Error())
+		}
+	}
+
+	config := format.GetServiceConfig(service)
+
+	return format.GetConfigFormat(config), cmd.Success
+}
+
+// initDummyService initializes the service using the dummy URL, to populate its config.
+func initDummyService(service types.Service, scheme string, logger *log.Logger) error {
+	dummyURL, _ := url.Parse(fmt.Sprintf("%s://dummy@dummy.com", scheme))
+
+	return service.Initialize(dummyURL, logger)
+}
@@ -0,0 +1,58 @@
+package docs
+
+import (
+	"log"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
+)
+
+func TestDocs(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Docs Command Suite")
+}
+
+var _ = ginkgo.Describe("the docs command", func() {
+	logger := log.New(ginkgo.GinkgoWriter, "", 0)
+
+	ginkgo.DescribeTable("should write the docs of every service",
+		func(docFormat string, extension string) {
+			dir := ginkgo.GinkgoT().TempDir()
+			gomega.Expect(printDocs(docFormat, services, dir)).To(gomega.Equal(cmd.Success))
+
+			for _, scheme := range services {
+				gomega.Expect(filepath.Join(dir, "shoutrrr-"+scheme+extension)).To(gomega.BeAnExistingFile())
+			}
+		},
+		ginkgo.Entry("as console text", "console", ".txt"),
+		ginkgo.Entry("as markdown", "markdown", ".md"),
+		ginkgo.Entry("as html", "html", ".html"),
+		ginkgo.Entry("as man pages", "man", ".7"),
+		ginkgo.Entry("as JSON schemas", formatJSONSchema, ".schema.json"),
+	)
+
+	ginkgo.It("should initialize every service from the dummy URL", func() {
+		for _, scheme := range services {
+			service, err := serviceRouter.NewService(scheme)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(initDummyService(service, scheme, logger)).To(gomega.Succeed(), scheme)
+		}
+	})
+
+	ginkgo.It("should return the config of every service", func() {
+		for _, scheme := range services {
+			configNode, res := getServiceConfigNode(scheme, logger)
+			gomega.Expect(res).To(gomega.Equal(cmd.Success), scheme)
+			gomega.Expect(configNode.Items).NotTo(gomega.BeNil(), scheme)
+		}
+	})
+
+	ginkgo.It("should return an error for unknown services", func() {
+		_, res := getServiceConfigNode("unknown", logger)
+		gomega.Expect(res.ExitCode).To(gomega.Equal(cmd.ExUsage))
+	})
+})
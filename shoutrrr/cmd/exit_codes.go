@@ -37,6 +37,14 @@ func ConfigurationError(message string) Result {
 	}
 }
 
+// PartialFailure returns a Result with the exit code ExPartialFailure.
+func PartialFailure(message string) Result {
+	return Result{
+		ExPartialFailure,
+		message,
+	}
+}
+
 const (
 	// ExSuccess is the exit code that signals that everything went as expected.
 	ExSuccess = 0
@@ -46,4 +54,6 @@ const (
 	ExUnavailable = 69
 	// ExConfig is the exit code that signals that the task failed due to a configuration error.
 	ExConfig = 78
+	// ExPartialFailure is the exit code that signals that the task failed for some, but not all, of the targets.
+	ExPartialFailure = 79
 )
@@ -3,11 +3,11 @@ package generate
 import (
 	"errors"
 	"fmt"
-	"net/url"
 	"os"
 	"strings"
 
 	"github.com/fatih/color"
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
 	"github.com/nicholas-fedor/shoutrrr/pkg/generators"
 	"github.com/nicholas-fedor/shoutrrr/pkg/router"
 	"github.com/nicholas-fedor/shoutrrr/pkg/types"
@@ -51,80 +51,6 @@ func init() {
 	Cmd.Flags().BoolP("show-sensitive", "x", false, "Show sensitive data in the generated URL (default: masked)")
 }
 
-// maskSensitiveURL masks sensitive parts of a Shoutrrr URL based on the service schema.
-// It redacts credentials like tokens, passwords, or user keys, tailoring the masking to specific services.
-func maskSensitiveURL(serviceSchema, urlStr string) string {
-	u, err := url.Parse(urlStr)
-	if err != nil {
-		return urlStr // Return original URL if parsing fails
-	}
-
-	switch serviceSchema {
-	case "discord", "slack", "teams":
-		maskUser(u, "REDACTED")
-	case "smtp":
-		maskSMTPUser(u)
-	case "pushover":
-		maskPushoverQuery(u)
-	case "gotify":
-		maskGotifyQuery(u)
-	default:
-		maskGeneric(u)
-	}
-
-	return u.String()
-}
-
-// maskUser redacts the username in a URL, replacing it with a placeholder.
-func maskUser(u *url.URL, placeholder string) {
-	if u.User != nil {
-		u.User = url.User(placeholder)
-	}
-}
-
-// maskSMTPUser redacts the password in an SMTP URL, preserving the username.
-func maskSMTPUser(u *url.URL) {
-	if u.User != nil {
-		u.User = url.UserPassword(u.User.Username(), "REDACTED")
-	}
-}
-
-// maskPushoverQuery redacts token and user query parameters in a Pushover URL.
-func maskPushoverQuery(u *url.URL) {
-	q := u.Query()
-	if q.Get("token") != "" {
-		q.Set("token", "REDACTED")
-	}
-
-	if q.Get("user") != "" {
-		q.Set("user", "REDACTED")
-	}
-
-	u.RawQuery = q.Encode()
-}
-
-// maskGotifyQuery redacts the token query parameter in a Gotify URL.
-func maskGotifyQuery(u *url.URL) {
-	q := u.Query()
-	if q.Get("token") != "" {
-		q.Set("token", "REDACTED")
-	}
-
-	u.RawQuery = q.Encode()
-}
-
-// maskGeneric redacts userinfo and all query parameters for unrecognized services.
-func maskGeneric(u *url.URL) {
-	maskUser(u, "REDACTED")
-
-	q := u.Query()
-	for key := range q {
-		q.Set(key, "REDACTED")
-	}
-
-	u.RawQuery = q.Encode()
-}
-
 // Run executes the generate command, producing a notification service URL.
 // It validates inputs, generates the URL using the specified service and generator,
 // and outputs it, masking sensitive data unless --show-sensitive is set.
@@ -215,12 +141,14 @@ func Run(cmd *cobra.Command, _ []string) {
 	}
 
 	fmt.Println()
+	fmt.Println("URL:", getDisplayURL(serviceConfig, showSensitive))
+}
 
-	maskedURL := maskSensitiveURL(serviceSchema, serviceConfig.GetURL().String())
-
+// getDisplayURL returns the URL of the generated config, with the secret values masked unless showSensitive is set.
+func getDisplayURL(config types.ServiceConfig, showSensitive bool) string {
 	if showSensitive {
-		fmt.Println("URL:", serviceConfig.GetURL().String())
-	} else {
-		fmt.Println("URL:", maskedURL)
+		return config.GetURL().String()
 	}
+
+	return format.RedactConfigURL(config)
 }
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// OutputText is the output format for human-readable text.
+	OutputText = "text"
+	// OutputJSON is the output format for machine-readable JSON.
+	OutputJSON = "json"
+)
+
+// AddOutputFlag adds the --output flag used to select the output format of a command.
+func AddOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("output", "o", OutputText, "Output format, either text or json")
+}
+
+// GetOutputFormat returns the output format selected using the --output flag.
+func GetOutputFormat(cmd *cobra.Command) (string, error) {
+	output, _ := cmd.Flags().GetString("output")
+
+	switch output {
+	case OutputText, OutputJSON:
+		return output, nil
+	default:
+		return "", fmt.Errorf("invalid output format %q, expected %s or %s", output, OutputText, OutputJSON)
+	}
+}
+
+// WriteJSON writes the value as indented JSON, followed by a newline.
+func WriteJSON(writer io.Writer, value any) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// SplitKeyValue splits a "key=value" flag argument into its key and value.
+func SplitKeyValue(pair string) (key string, value string, err error) {
+	key, value, found := strings.Cut(pair, "=")
+	if !found || key == "" {
+		return "", "", fmt.Errorf("invalid key/value pair %q, expected key=value", pair)
+	}
+
+	return key, value, nil
+}
+
+// ValidateParams checks the param keys against the keys used by the services. It returns an error for the keys
+// that are not used by any of the services, and a warning for each service that does not use a key that is used
+// by another service.
+func ValidateParams(services []types.Service, params types.Params) (warnings []string, err error) {
+	unknownKeys := make([][]string, len(services))
+
+	for i, service := range services {
+		unknownKeys[i] = format.GetUnknownParamKeys(service, params)
+	}
+
+	errs := []error{}
+
+	for i, service := range services {
+		ignoredKeys := []string{}
+		invalidKeys := []string{}
+
+		for _, key := range unknownKeys[i] {
+			if isUnknownToAll(unknownKeys, key) {
+				invalidKeys = append(invalidKeys, key)
+			} else {
+				ignoredKeys = append(ignoredKeys, key)
+			}
+		}
+
+		if len(ignoredKeys) > 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				"param key(s) %s are not used by service %q", strings.Join(ignoredKeys, ", "), service.GetID()))
+		}
+
+		if len(invalidKeys) > 0 {
+			validKeys, _ := format.GetParamKeys(service)
+			errs = append(errs, fmt.Errorf(
+				"unknown param key(s) for service %q: %s (valid keys: %s)",
+				service.GetID(),
+				strings.Join(invalidKeys, ", "),
+				strings.Join(validKeys, ", "),
+			))
+		}
+	}
+
+	return warnings, errors.Join(errs...)
+}
+
+func isUnknownToAll(unknownKeys [][]string, key string) bool {
+	for _, keys := range unknownKeys {
+		if !slices.Contains(keys, key) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -6,11 +6,13 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/nicholas-fedor/shoutrrr/internal/dedupe"
 	internalUtil "github.com/nicholas-fedor/shoutrrr/internal/util"
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
 	"github.com/nicholas-fedor/shoutrrr/pkg/router"
 	"github.com/nicholas-fedor/shoutrrr/pkg/types"
 	"github.com/nicholas-fedor/shoutrrr/pkg/util"
@@ -34,13 +36,27 @@ var Cmd = &cobra.Command{
 func init() {
 	Cmd.Flags().BoolP("verbose", "v", false, "")
 
+	// The url and message flags are required unless --batch is used, which is validated in run
 	Cmd.Flags().StringArrayP("url", "u", []string{}, "The notification url")
-	_ = Cmd.MarkFlagRequired("url")
-
 	Cmd.Flags().StringP("message", "m", "", "The message to send to the notification url, or - to read message from stdin")
-	_ = Cmd.MarkFlagRequired("message")
 
 	Cmd.Flags().StringP("title", "t", "", "The title used for services that support it")
+
+	Cmd.Flags().StringArrayP("param", "p", []string{}, "Additional param in key=value format (e.g. priority=high), can be repeated")
+	Cmd.Flags().String("params-file", "", "A JSON or YAML file containing a map of additional params")
+	Cmd.Flags().StringArray("template", []string{}, "Template file to load in id=path format, can be repeated")
+
+	Cmd.Flags().Int("retries", 0, "The number of times to retry sending to a service after it fails")
+	Cmd.Flags().Duration("retry-delay", router.DefaultRetryDelay, "The delay before the first retry, doubled for each subsequent retry")
+	Cmd.Flags().Float64("rate", 0, "The maximum number of send attempts started per second, or 0 for no limit")
+
+	Cmd.Flags().String("batch", "", "A NDJSON or CSV file with one notification per record, instead of --url and --message")
+	Cmd.Flags().Int("concurrency", DefaultBatchConcurrency, "The maximum number of batch records to send at the same time")
+	Cmd.Flags().String("progress", "", "A file used to record the batch progress, records already sent are skipped")
+
+	cli.AddOutputFlag(Cmd)
+	cli.RegisterURLCompletion(Cmd, "url")
+	cli.RegisterParamCompletion(Cmd, "param", "url")
 }
 
 func logf(format string, a ...any) {
@@ -55,6 +71,40 @@ func run(cmd *cobra.Command) error {
 	urls = dedupe.RemoveDuplicates(urls)
 	message, _ := flags.GetString("message")
 	title, _ := flags.GetString("title")
+	paramFlags, _ := flags.GetStringArray("param")
+	paramsFile, _ := flags.GetString("params-file")
+	templateFlags, _ := flags.GetStringArray("template")
+
+	output, err := cli.GetOutputFormat(cmd)
+	if err != nil {
+		return cli.InvalidUsage(err.Error())
+	}
+
+	delivery, err := getDeliveryOptions(cmd)
+	if err != nil {
+		return cli.InvalidUsage(err.Error())
+	}
+
+	params, err := buildParams(paramsFile, paramFlags, title)
+	if err != nil {
+		return cli.InvalidUsage(err.Error())
+	}
+
+	if batchFile, _ := flags.GetString("batch"); batchFile != "" {
+		if len(urls) > 0 || message != "" {
+			return cli.InvalidUsage("the url and message flags cannot be used together with --batch")
+		}
+
+		return runBatch(cmd, batchFile, params, templateFlags, delivery, output, verbose)
+	}
+
+	if len(urls) < 1 {
+		return cli.InvalidUsage(`required flag(s) "url" not set`)
+	}
+
+	if message == "" {
+		return cli.InvalidUsage(`required flag(s) "message" not set`)
+	}
 
 	if message == "-" {
 		logf("Reading from STDIN...")
@@ -74,9 +124,21 @@ func run(cmd *cobra.Command) error {
 	var logger *log.Logger
 
 	if verbose {
+		logger = log.New(os.Stderr, "SHOUTRRR ", log.LstdFlags)
+	} else {
+		logger = util.DiscardLogger
+	}
+
+	sr, err := router.New(logger, urls...)
+	if err != nil {
+		return cli.ConfigurationError(fmt.Sprintf("error invoking send: %s", err))
+	}
+
+	if verbose {
+		// The URLs are logged after the services have been initialized, so that their secret values can be masked
 		urlsPrefix := "URLs:"
-		for i, url := range urls {
-			logf("%s %s", urlsPrefix, url)
+		for i, service := range sr.Services() {
+			logf("%s %s", urlsPrefix, format.RedactURL(service))
 
 			if i == 0 {
 				// Only display "URLs:" prefix for first line, replace with indentation for the subsequent
@@ -90,31 +152,141 @@ func run(cmd *cobra.Command) error {
 			logf("Title: %v", title)
 		}
 
-		logger = log.New(os.Stderr, "SHOUTRRR ", log.LstdFlags)
-	} else {
-		logger = util.DiscardLogger
+		for _, key := range sortedKeys(params) {
+			logf("Param: %s=%s", key, params[key])
+		}
 	}
 
-	sr, err := router.New(logger, urls...)
+	delivery.apply(sr)
+
+	warnings, err := cli.ValidateParams(sr.Services(), params)
 	if err != nil {
-		return cli.ConfigurationError(fmt.Sprintf("error invoking send: %s", err))
+		return cli.InvalidUsage(err.Error())
+	}
+
+	for _, warning := range warnings {
+		logf("Warning: %s", warning)
+	}
+
+	for _, service := range sr.Services() {
+		if err := applyTemplates(service, templateFlags); err != nil {
+			return cli.ConfigurationError(err.Error())
+		}
+	}
+
+	results := sr.SendWithResults(message, &params)
+
+	if output == cli.OutputJSON {
+		if err := cli.WriteJSON(os.Stdout, newSendReport(results)); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
 	} else {
-		params := make(types.Params)
-		if title != "" {
-			params["title"] = title
+		for _, result := range results {
+			if result.Success() {
+				logf("Notification sent")
+			} else {
+				logf("%s", result.Err)
+			}
 		}
+	}
+
+	return getSendResult(results)
+}
+
+func runBatch(
+	cmd *cobra.Command,
+	batchFile string,
+	params types.Params,
+	templateFlags []string,
+	delivery deliveryOptions,
+	output string,
+	verbose bool,
+) error {
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+	progressFile, _ := cmd.Flags().GetString("progress")
+
+	if concurrency < 1 {
+		return cli.InvalidUsage("concurrency must be at least 1")
+	}
+
+	records, err := readBatchFile(batchFile)
+	if err != nil {
+		return cli.InvalidUsage(err.Error())
+	}
+
+	var logger types.StdLogger = util.DiscardLogger
+	if verbose {
+		logf("Batch: %d record(s) from %s", len(records), batchFile)
 
-		errs := sr.SendAsync(message, &params)
-		for err := range errs {
-			if err != nil {
-				return cli.TaskUnavailable(err.Error())
+		logger = log.New(os.Stderr, "SHOUTRRR ", log.LstdFlags)
+	}
+
+	report, err := sendBatch(records, batchOptions{
+		Params:        params,
+		TemplateFlags: templateFlags,
+		Concurrency:   concurrency,
+		ProgressFile:  progressFile,
+		Delivery:      delivery,
+		Logger:        logger,
+	})
+	if err != nil {
+		return cli.TaskUnavailable(err.Error())
+	}
+
+	if output == cli.OutputJSON {
+		if err := cli.WriteJSON(os.Stdout, report); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+	} else {
+		for _, result := range report.Records {
+			if result.Success {
+				logf("Record %d: Notification sent", result.Record)
+			} else {
+				logf("Record %d: %s", result.Record, result.Error)
 			}
+		}
 
-			logf("Notification sent")
+		if report.Skipped > 0 {
+			logf("Skipped %d record(s) that were already sent", report.Skipped)
 		}
 	}
 
-	return nil
+	return getBatchResult(report)
+}
+
+// deliveryOptions contains the retry and rate limiting settings used for the router(s).
+type deliveryOptions struct {
+	Retries     int
+	RetryDelay  time.Duration
+	RateLimiter *router.RateLimiter
+}
+
+func getDeliveryOptions(cmd *cobra.Command) (deliveryOptions, error) {
+	retries, _ := cmd.Flags().GetInt("retries")
+	retryDelay, _ := cmd.Flags().GetDuration("retry-delay")
+	rate, _ := cmd.Flags().GetFloat64("rate")
+
+	switch {
+	case retries < 0:
+		return deliveryOptions{}, fmt.Errorf("retries cannot be negative")
+	case retryDelay < 0:
+		return deliveryOptions{}, fmt.Errorf("retry-delay cannot be negative")
+	case rate < 0:
+		return deliveryOptions{}, fmt.Errorf("rate cannot be negative")
+	}
+
+	delivery := deliveryOptions{Retries: retries, RetryDelay: retryDelay}
+	if rate > 0 {
+		delivery.RateLimiter = router.NewRateLimiter(rate)
+	}
+
+	return delivery, nil
+}
+
+func (delivery deliveryOptions) apply(sr *router.ServiceRouter) {
+	sr.Retries = delivery.Retries
+	sr.RetryDelay = delivery.RetryDelay
+	sr.RateLimiter = delivery.RateLimiter
 }
 
 // Run the send command.
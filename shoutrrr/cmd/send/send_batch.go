@@ -0,0 +1,479 @@
+package send
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/router"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	cli "github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
+)
+
+// DefaultBatchConcurrency is the default number of batch records that are sent at the same time.
+const DefaultBatchConcurrency = 4
+
+// maxBatchLineLength is the maximum length of a single NDJSON record.
+const maxBatchLineLength = 1024 * 1024
+
+// CSV columns that are used for the record fields, all other columns are used as params.
+const (
+	csvColumnURL     = "url"
+	csvColumnProfile = "profile"
+	csvColumnMessage = "message"
+	csvColumnTitle   = "title"
+)
+
+// batchRecord is a single notification read from a batch file.
+type batchRecord struct {
+	// Number is the 1-based position of the record in the batch file, and is used to identify it in the results.
+	Number int    `json:"-"`
+	URL    string `json:"url"`
+	// Profile is the scheme of a service that is configured using environment variables, and is used
+	// instead of the URL (see router.ServiceRouter.LocateFromEnv).
+	Profile string       `json:"profile"`
+	Message string       `json:"message"`
+	Title   string       `json:"title"`
+	Params  types.Params `json:"params"`
+	// parseErr is set if the record could not be parsed, and is reported as the record result.
+	parseErr error
+}
+
+// batchOptions contains the flags that apply to all records in a batch.
+type batchOptions struct {
+	Params        types.Params
+	TemplateFlags []string
+	Concurrency   int
+	ProgressFile  string
+	Delivery      deliveryOptions
+	Logger        types.StdLogger
+}
+
+// batchReport is the JSON output of the send command in batch mode.
+type batchReport struct {
+	Records []batchRecordResult `json:"records"`
+	Sent    int                 `json:"sent"`
+	Failed  int                 `json:"failed"`
+	Skipped int                 `json:"skipped"`
+}
+
+// batchRecordResult is the JSON representation of the outcome of sending a single batch record.
+type batchRecordResult struct {
+	Record  int          `json:"record"`
+	Success bool         `json:"success"`
+	Error   string       `json:"error,omitempty"`
+	Results []sendResult `json:"results,omitempty"`
+}
+
+// progressEntry is a single line in the progress file, written when a record has been processed.
+// Records are identified by a hash of their contents, so that the progress still applies if the
+// batch file is edited between runs. The record number is only included for reference.
+type progressEntry struct {
+	Record  int    `json:"record"`
+	Key     string `json:"key"`
+	Success bool   `json:"success"`
+}
+
+// key returns the hash of the record contents that is used to identify it in the progress file.
+// Records that could not be parsed have no key, and are never considered completed.
+func (record batchRecord) key() string {
+	if record.parseErr != nil {
+		return ""
+	}
+
+	// Map keys are sorted when marshaled, so the encoding only depends on the record contents
+	fields := map[string]any{
+		"url":     record.URL,
+		"message": record.Message,
+		"title":   record.Title,
+		"params":  record.Params,
+	}
+
+	// The profile is only included when set, so that the keys of records with an URL stay the same
+	if record.Profile != "" {
+		fields["profile"] = record.Profile
+	}
+
+	contents, _ := json.Marshal(fields)
+	hash := sha256.Sum256(contents)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// readBatchFile reads the records from a CSV file (if the file has a .csv extension) or a NDJSON file.
+func readBatchFile(path string) ([]batchRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open batch file: %w", err)
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return readBatchCSV(file)
+	}
+
+	return readBatchNDJSON(file)
+}
+
+// readBatchNDJSON reads one record per line, ignoring blank lines. Lines that cannot be parsed are
+// returned as records with a parse error, so that they are reported without stopping the batch.
+func readBatchNDJSON(reader io.Reader) ([]batchRecord, error) {
+	records := []batchRecord{}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxBatchLineLength)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		record := batchRecord{}
+		decoder := json.NewDecoder(bytes.NewReader([]byte(line)))
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(&record); err != nil {
+			record = batchRecord{parseErr: fmt.Errorf("failed to parse record: %w", err)}
+		}
+
+		record.Number = len(records) + 1
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read batch file: %w", err)
+	}
+
+	return records, nil
+}
+
+// readBatchCSV reads one record per row, using the header row to identify the columns.
+func readBatchCSV(reader io.Reader) ([]batchRecord, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read batch file header: %w", err)
+	}
+
+	for i, column := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+	}
+
+	records := []batchRecord{}
+
+	for {
+		row, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		record := batchRecord{Number: len(records) + 1}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("failed to read batch file: %w", err)
+			}
+
+			record.parseErr = fmt.Errorf("failed to parse record: %w", err)
+			records = append(records, record)
+
+			continue
+		}
+
+		record.Params = types.Params{}
+
+		for i, value := range row {
+			if value == "" || i >= len(header) {
+				continue
+			}
+
+			switch header[i] {
+			case csvColumnURL:
+				record.URL = value
+			case csvColumnProfile:
+				record.Profile = value
+			case csvColumnMessage:
+				record.Message = value
+			case csvColumnTitle:
+				record.Title = value
+			default:
+				record.Params[header[i]] = value
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// loadProgress returns the number of times that records with each key have already been sent successfully,
+// according to the progress file. A missing progress file is treated as no progress.
+func loadProgress(path string) (map[string]int, error) {
+	completed := map[string]int{}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return completed, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open progress file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry := progressEntry{}
+		// Ignore lines that cannot be parsed, like a partially written last line
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+
+		if entry.Success && entry.Key != "" {
+			completed[entry.Key]++
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read progress file: %w", err)
+	}
+
+	return completed, nil
+}
+
+// progressWriter appends the record results to the progress file, as they are completed.
+type progressWriter struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+func openProgressWriter(path string) (*progressWriter, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open progress file: %w", err)
+	}
+
+	return &progressWriter{file: file}, nil
+}
+
+func (pw *progressWriter) write(record batchRecord, result batchRecordResult) error {
+	if pw == nil {
+		return nil
+	}
+
+	line, err := json.Marshal(progressEntry{Record: record.Number, Key: record.key(), Success: result.Success})
+	if err != nil {
+		return err
+	}
+
+	pw.mutex.Lock()
+	defer pw.mutex.Unlock()
+
+	_, err = pw.file.Write(append(line, '\n'))
+
+	return err
+}
+
+func (pw *progressWriter) close() error {
+	if pw == nil {
+		return nil
+	}
+
+	return pw.file.Close()
+}
+
+// sendBatch sends all records that are not already completed according to the progress file, using
+// at most opts.Concurrency workers, and returns the report with the records in file order. If the batch
+// contains identical records, only as many of them are skipped as have been completed.
+func sendBatch(records []batchRecord, opts batchOptions) (batchReport, error) {
+	report := batchReport{}
+
+	completed := map[string]int{}
+
+	if opts.ProgressFile != "" {
+		var err error
+		if completed, err = loadProgress(opts.ProgressFile); err != nil {
+			return report, err
+		}
+	}
+
+	progress, err := openProgressWriter(opts.ProgressFile)
+	if err != nil {
+		return report, err
+	}
+	defer progress.close()
+
+	pending := make([]batchRecord, 0, len(records))
+
+	for _, record := range records {
+		if key := record.key(); completed[key] > 0 {
+			completed[key]--
+			report.Skipped++
+
+			continue
+		}
+
+		pending = append(pending, record)
+	}
+
+	concurrency := max(opts.Concurrency, 1)
+	results := make([]batchRecordResult, len(pending))
+	queue := make(chan int)
+	wg := sync.WaitGroup{}
+
+	var progressErr error
+
+	var progressErrOnce sync.Once
+
+	for range concurrency {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range queue {
+				results[i] = sendRecord(pending[i], opts)
+
+				if err := progress.write(pending[i], results[i]); err != nil {
+					progressErrOnce.Do(func() { progressErr = fmt.Errorf("failed to write progress file: %w", err) })
+				}
+			}
+		}()
+	}
+
+	for i := range pending {
+		queue <- i
+	}
+
+	close(queue)
+	wg.Wait()
+
+	for _, result := range results {
+		if result.Success {
+			report.Sent++
+		} else {
+			report.Failed++
+		}
+	}
+
+	report.Records = results
+
+	return report, progressErr
+}
+
+// sendRecord sends a single batch record to its URL, using the batch params as defaults for the record params.
+func sendRecord(record batchRecord, opts batchOptions) batchRecordResult {
+	result := batchRecordResult{Record: record.Number}
+
+	fail := func(err error) batchRecordResult {
+		result.Error = err.Error()
+
+		return result
+	}
+
+	switch {
+	case record.parseErr != nil:
+		return fail(record.parseErr)
+	case record.URL == "" && record.Profile == "":
+		return fail(fmt.Errorf("no url or profile specified"))
+	case record.URL != "" && record.Profile != "":
+		return fail(fmt.Errorf("both url and profile specified"))
+	case record.Message == "":
+		return fail(fmt.Errorf("no message specified"))
+	}
+
+	params := types.Params{}
+
+	for key, value := range opts.Params {
+		params[key] = value
+	}
+
+	for key, value := range record.Params {
+		params[key] = value
+	}
+
+	if record.Title != "" {
+		params.SetTitle(record.Title)
+	}
+
+	sr, err := newRecordRouter(record, opts.Logger)
+	if err != nil {
+		return fail(err)
+	}
+
+	// The rate limiter is shared between all records, limiting the rate of the whole batch
+	opts.Delivery.apply(sr)
+
+	if _, err := cli.ValidateParams(sr.Services(), params); err != nil {
+		return fail(err)
+	}
+
+	for _, service := range sr.Services() {
+		if err := applyTemplates(service, opts.TemplateFlags); err != nil {
+			return fail(err)
+		}
+	}
+
+	sendResults := sr.SendWithResults(record.Message, &params)
+	result.Results = newSendReport(sendResults).Results
+	result.Success = true
+
+	for _, sendResult := range sendResults {
+		if !sendResult.Success() {
+			result.Success = false
+			result.Error = sendResult.Err.Error()
+		}
+	}
+
+	return result
+}
+
+// getBatchResult returns nil if all records were sent (or skipped), or a cli.Result with an exit code
+// depending on whether some or all of them failed.
+func getBatchResult(report batchReport) error {
+	total := report.Sent + report.Failed
+
+	switch {
+	case report.Failed == 0:
+		return nil
+	case report.Failed < total:
+		return cli.PartialFailure(fmt.Sprintf("failed to send %d of %d batch record(s)", report.Failed, total))
+	default:
+		return cli.TaskUnavailable(fmt.Sprintf("failed to send all %d batch record(s)", report.Failed))
+	}
+}
+
+// newRecordRouter returns a router for the URL of the record, or for the service of its profile, which is
+// configured using the SHOUTRRR_<SERVICE>_<FIELD> environment variables.
+func newRecordRouter(record batchRecord, logger types.StdLogger) (*router.ServiceRouter, error) {
+	if record.Profile == "" {
+		return router.New(logger, record.URL)
+	}
+
+	sr, err := router.New(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sr.AddServiceFromEnv(record.Profile); err != nil {
+		return nil, fmt.Errorf("failed to configure profile %q from the environment: %w", record.Profile, err)
+	}
+
+	return sr, nil
+}
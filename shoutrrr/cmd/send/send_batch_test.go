@@ -0,0 +1,229 @@
+package send
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/util"
+	cli "github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
+)
+
+func TestSend(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Send Command Suite")
+}
+
+var _ = ginkgo.Describe("batch sending", func() {
+	var dir string
+
+	ginkgo.BeforeEach(func() {
+		dir = ginkgo.GinkgoT().TempDir()
+	})
+
+	// fileURL returns a URL for the file service that writes to a file in the test directory.
+	fileURL := func(name string) string {
+		return "file://" + filepath.Join(dir, name)
+	}
+
+	// failingURL returns a URL for the file service that always fails, since the directory cannot be created.
+	failingURL := func() string {
+		return "file:///dev/null/out.ndjson"
+	}
+
+	writeBatch := func(name string, lines ...string) string {
+		path := filepath.Join(dir, name)
+		gomega.Expect(os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o600)).To(gomega.Succeed())
+
+		return path
+	}
+
+	record := func(url, message string) string {
+		line, err := json.Marshal(map[string]string{"url": url, "message": message})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		return string(line)
+	}
+
+	options := func() batchOptions {
+		return batchOptions{
+			Concurrency:  2,
+			ProgressFile: filepath.Join(dir, "progress.ndjson"),
+			Logger:       util.DiscardLogger,
+		}
+	}
+
+	ginkgo.When("reading malformed records", func() {
+		ginkgo.It("should report NDJSON records that cannot be parsed without stopping the batch", func() {
+			path := writeBatch("batch.ndjson",
+				`{"url": "logger://", "message": "first"}`,
+				`{not json`,
+				`{"url": "logger://", "message": "third", "channel": "ops"}`,
+				`{"url": "logger://", "message": "fourth"}`,
+			)
+
+			records, err := readBatchFile(path)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(records).To(gomega.HaveLen(4))
+
+			report, err := sendBatch(records, options())
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(report.Sent).To(gomega.Equal(2))
+			gomega.Expect(report.Failed).To(gomega.Equal(2))
+			gomega.Expect(report.Records[1].Record).To(gomega.Equal(2))
+			gomega.Expect(report.Records[1].Error).To(gomega.HavePrefix("failed to parse record"))
+			gomega.Expect(report.Records[2].Error).To(gomega.ContainSubstring(`unknown field "channel"`))
+		})
+		ginkgo.It("should report CSV rows that cannot be parsed without stopping the batch", func() {
+			path := writeBatch("batch.csv",
+				"url,message,title",
+				"logger://,first,Title",
+				`logger://,"unterminated`,
+			)
+
+			records, err := readBatchFile(path)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(records).To(gomega.HaveLen(2))
+			gomega.Expect(records[0].Title).To(gomega.Equal("Title"))
+			gomega.Expect(records[1].parseErr).To(gomega.HaveOccurred())
+		})
+		ginkgo.It("should report records that are missing the url or message", func() {
+			result := sendRecord(batchRecord{Number: 1, Message: "message"}, options())
+			gomega.Expect(result.Error).To(gomega.Equal("no url or profile specified"))
+
+			result = sendRecord(batchRecord{Number: 2, URL: "logger://"}, options())
+			gomega.Expect(result.Error).To(gomega.Equal("no message specified"))
+
+			result = sendRecord(batchRecord{Number: 3, URL: "logger://", Profile: "logger", Message: "message"}, options())
+			gomega.Expect(result.Error).To(gomega.Equal("both url and profile specified"))
+		})
+	})
+
+	ginkgo.When("records use a profile", func() {
+		ginkgo.It("should send using the service configured from the environment", func() {
+			output := filepath.Join(dir, "profile.ndjson")
+			ginkgo.GinkgoT().Setenv("SHOUTRRR_FILE_PATH", output)
+
+			records, err := readBatchFile(writeBatch("batch.csv",
+				"profile,message",
+				"file,first",
+			))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(records[0].Profile).To(gomega.Equal("file"))
+
+			report, err := sendBatch(records, options())
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(report.Sent).To(gomega.Equal(1))
+			written, err := os.ReadFile(output)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(string(written)).To(gomega.ContainSubstring("first"))
+		})
+		ginkgo.It("should report profiles that are not configured", func() {
+			result := sendRecord(batchRecord{Number: 1, Profile: "file", Message: "message"}, options())
+			gomega.Expect(result.Error).To(gomega.HavePrefix(`failed to configure profile "file" from the environment`))
+		})
+	})
+
+	ginkgo.When("some of the records fail", func() {
+		ginkgo.It("should report the result of each record and a partial failure", func() {
+			records, err := readBatchFile(writeBatch("batch.ndjson",
+				record(fileURL("out.ndjson"), "first"),
+				record(failingURL(), "second"),
+			))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			report, err := sendBatch(records, options())
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(report.Sent).To(gomega.Equal(1))
+			gomega.Expect(report.Failed).To(gomega.Equal(1))
+			gomega.Expect(report.Records[0].Success).To(gomega.BeTrue())
+			gomega.Expect(report.Records[0].Results).To(gomega.HaveLen(1))
+			gomega.Expect(report.Records[1].Success).To(gomega.BeFalse())
+			gomega.Expect(report.Records[1].Error).NotTo(gomega.BeEmpty())
+
+			result, ok := getBatchResult(report).(cli.Result)
+			gomega.Expect(ok).To(gomega.BeTrue())
+			gomega.Expect(result.ExitCode).To(gomega.Equal(cli.ExPartialFailure))
+		})
+		ginkgo.It("should report a failure if all of the records fail", func() {
+			records, err := readBatchFile(writeBatch("batch.ndjson", record(failingURL(), "first")))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			report, err := sendBatch(records, options())
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			result, ok := getBatchResult(report).(cli.Result)
+			gomega.Expect(ok).To(gomega.BeTrue())
+			gomega.Expect(result.ExitCode).To(gomega.Equal(cli.ExUnavailable))
+		})
+	})
+
+	ginkgo.When("resuming a batch", func() {
+		ginkgo.It("should only send the records that were not sent successfully", func() {
+			output := fileURL("out.ndjson")
+			batch := writeBatch("batch.ndjson", record(output, "first"), record(failingURL(), "second"))
+			opts := options()
+
+			records, err := readBatchFile(batch)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			report, err := sendBatch(records, opts)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(report.Failed).To(gomega.Equal(1))
+
+			// Fix the failing record and reorder the batch, the first record should still be skipped
+			batch = writeBatch("batch.ndjson", record(output, "second"), record(output, "first"))
+			records, err = readBatchFile(batch)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			report, err = sendBatch(records, opts)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(report.Skipped).To(gomega.Equal(1))
+			gomega.Expect(report.Sent).To(gomega.Equal(1))
+			gomega.Expect(report.Records).To(gomega.HaveLen(1))
+			gomega.Expect(report.Records[0].Record).To(gomega.Equal(1))
+
+			written, err := os.ReadFile(filepath.Join(dir, "out.ndjson"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(strings.Count(string(written), "\n")).To(gomega.Equal(2))
+		})
+		ginkgo.It("should ignore a partially written last line in the progress file", func() {
+			records, err := readBatchFile(writeBatch("batch.ndjson", record(fileURL("out.ndjson"), "first")))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			entry, err := json.Marshal(progressEntry{Record: 1, Key: records[0].key(), Success: true})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			opts := options()
+			progress := string(entry) + "\n" + `{"record": 2, "key": "ab`
+			gomega.Expect(os.WriteFile(opts.ProgressFile, []byte(progress), 0o600)).To(gomega.Succeed())
+
+			report, err := sendBatch(records, opts)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(report.Skipped).To(gomega.Equal(1))
+			gomega.Expect(report.Records).To(gomega.BeEmpty())
+		})
+		ginkgo.It("should only skip as many identical records as were sent", func() {
+			output := fileURL("out.ndjson")
+			opts := options()
+
+			records, err := readBatchFile(writeBatch("batch.ndjson", record(output, "same")))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, err = sendBatch(records, opts)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			records, err = readBatchFile(writeBatch("batch.ndjson", record(output, "same"), record(output, "same")))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			report, err := sendBatch(records, opts)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(report.Skipped).To(gomega.Equal(1))
+			gomega.Expect(report.Sent).To(gomega.Equal(1))
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package send
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	cli "github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
+)
+
+// loadParamsFile reads a flat map of params from a JSON or YAML file.
+func loadParamsFile(path string) (types.Params, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read params file: %w", err)
+	}
+
+	// YAML is a superset of JSON, so the same decoder can handle both formats
+	params := types.Params{}
+	if err := yaml.Unmarshal(data, &params); err != nil {
+		return nil, fmt.Errorf("failed to parse params file %q: %w", path, err)
+	}
+
+	return params, nil
+}
+
+// buildParams merges the params from the params file, the param flags and the title flag,
+// with each latter source taking precedence over the former.
+func buildParams(paramsFile string, paramFlags []string, title string) (types.Params, error) {
+	params := types.Params{}
+
+	if paramsFile != "" {
+		fileParams, err := loadParamsFile(paramsFile)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range fileParams {
+			params[key] = value
+		}
+	}
+
+	for _, pair := range paramFlags {
+		key, value, err := cli.SplitKeyValue(pair)
+		if err != nil {
+			return nil, err
+		}
+
+		params[key] = value
+	}
+
+	if title != "" {
+		params.SetTitle(title)
+	}
+
+	return params, nil
+}
+
+// applyTemplates loads the template files, given as "id=path" pairs, into the service.
+func applyTemplates(service types.Service, templateFlags []string) error {
+	for _, pair := range templateFlags {
+		id, path, err := cli.SplitKeyValue(pair)
+		if err != nil {
+			return err
+		}
+
+		if err := service.SetTemplateFile(id, path); err != nil {
+			return fmt.Errorf("failed to load template %q for service %q: %w", id, service.GetID(), err)
+		}
+	}
+
+	return nil
+}
+
+// sortedKeys returns the param keys in alphabetical order.
+func sortedKeys(params types.Params) []string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
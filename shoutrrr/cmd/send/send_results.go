@@ -0,0 +1,74 @@
+package send
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/router"
+	cli "github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
+)
+
+// sendReport is the JSON output of the send command.
+type sendReport struct {
+	Results []sendResult `json:"results"`
+	Sent    int          `json:"sent"`
+	Failed  int          `json:"failed"`
+}
+
+// sendResult is the JSON representation of the outcome of sending to a single URL.
+type sendResult struct {
+	Index      int     `json:"index"`
+	Service    string  `json:"service"`
+	URL        string  `json:"url"`
+	Success    bool    `json:"success"`
+	Error      string  `json:"error,omitempty"`
+	DurationMs float64 `json:"durationMs"`
+}
+
+func newSendReport(results []router.SendResult) sendReport {
+	report := sendReport{
+		Results: make([]sendResult, 0, len(results)),
+	}
+
+	for _, result := range results {
+		item := sendResult{
+			Index:      result.Index,
+			Service:    result.ServiceID,
+			URL:        result.URL,
+			Success:    result.Success(),
+			DurationMs: float64(result.Duration) / float64(time.Millisecond),
+		}
+
+		if result.Success() {
+			report.Sent++
+		} else {
+			item.Error = result.Err.Error()
+			report.Failed++
+		}
+
+		report.Results = append(report.Results, item)
+	}
+
+	return report
+}
+
+// getSendResult returns nil if all notifications were sent, or a cli.Result with an exit code
+// depending on whether some or all of them failed.
+func getSendResult(results []router.SendResult) error {
+	failed := 0
+
+	for _, result := range results {
+		if !result.Success() {
+			failed++
+		}
+	}
+
+	switch {
+	case failed == 0:
+		return nil
+	case failed < len(results):
+		return cli.PartialFailure(fmt.Sprintf("failed to send %d of %d notification(s)", failed, len(results)))
+	default:
+		return cli.TaskUnavailable(fmt.Sprintf("failed to send all %d notification(s)", failed))
+	}
+}
@@ -2,13 +2,15 @@ package verify
 
 import (
 	"fmt"
+	"log"
+	"net/url"
 	"os"
-	"strings"
 
 	"github.com/fatih/color"
 	"github.com/nicholas-fedor/shoutrrr/internal/util"
 	"github.com/nicholas-fedor/shoutrrr/pkg/format"
 	"github.com/nicholas-fedor/shoutrrr/pkg/router"
+	cli "github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
 
 	"github.com/spf13/cobra"
 )
@@ -27,36 +29,116 @@ var sr router.ServiceRouter
 func init() {
 	Cmd.Flags().StringP("url", "u", "", "The notification url")
 	_ = Cmd.MarkFlagRequired("url")
+
+	Cmd.Flags().Bool("online", false, "Also verify the configuration against the remote service API")
+	Cmd.Flags().StringArrayP("param", "p", []string{}, "Param override in key=value format to apply to the config, can be repeated")
+
+	cli.AddOutputFlag(Cmd)
+	cli.RegisterURLCompletion(Cmd, "url")
+	cli.RegisterParamCompletion(Cmd, "param", "url")
+}
+
+// verifyResult is the JSON output of the verify command.
+type verifyResult struct {
+	Service  string             `json:"service,omitempty"`
+	Valid    bool               `json:"valid"`
+	Error    string             `json:"error,omitempty"`
+	Warnings []string           `json:"warnings,omitempty"`
+	Fields   []format.JSONField `json:"fields,omitempty"`
+	Online   *onlineResult      `json:"online,omitempty"`
 }
 
 // Run the verify command.
 func Run(cmd *cobra.Command, _ []string) {
 	URL, _ := cmd.Flags().GetString("url")
+	online, _ := cmd.Flags().GetBool("online")
+	paramFlags, _ := cmd.Flags().GetStringArray("param")
 	sr = router.ServiceRouter{}
 
+	output, err := cli.GetOutputFormat(cmd)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(cli.ExUsage)
+	}
+
+	params, err := parseParams(paramFlags)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(cli.ExUsage)
+	}
+
 	service, err := sr.Locate(URL)
+	if err == nil {
+		err = applyParams(service, params)
+	}
+
+	// The field sources are resolved from the URL the service was initialized with, since custom URLs are converted
+	var serviceURL *url.URL
+	if err == nil {
+		serviceURL, err = sr.ConfigURL(URL)
+	}
+
 	if err != nil {
-		fmt.Printf("error verifying URL: %s\n", sanitizeError(err))
+		if output == cli.OutputJSON {
+			_ = cli.WriteJSON(os.Stdout, verifyResult{Valid: false, Error: err.Error()})
+		} else {
+			fmt.Printf("error verifying URL: %s\n", err.Error())
+		}
+
 		os.Exit(1)
 	}
 
 	config := format.GetServiceConfig(service)
 	configNode := format.GetConfigFormat(config)
+	format.SetFieldSources(configNode, config, format.FieldSources{URL: serviceURL, Params: &params})
+	warnings := getQueryWarnings(service.GetID(), config, serviceURL)
 
-	_, _ = fmt.Fprint(color.Output, format.ColorFormatTree(configNode, true))
-}
+	var onlineRes *onlineResult
+
+	if online {
+		if output == cli.OutputText {
+			service.SetLogger(log.New(os.Stderr, "", 0))
+		}
+
+		res := verifyOnline(service)
+		onlineRes = &res
+	}
 
-// sanitizeError removes sensitive details from an error message.
-func sanitizeError(err error) string {
-	errStr := err.Error()
-	// Check for common error patterns without exposing URL details
-	if strings.Contains(errStr, "unknown service") {
-		return "service not recognized"
+	if output == cli.OutputJSON {
+		serviceID := service.GetID()
+		renderer := format.JSONTreeRenderer{WithValues: true}
+
+		_ = cli.WriteJSON(os.Stdout, verifyResult{
+			Service:  serviceID,
+			Valid:    true,
+			Warnings: warnings,
+			Fields:   renderer.ServiceConfig(configNode, serviceID).Fields,
+			Online:   onlineRes,
+		})
+	} else {
+		for _, warning := range warnings {
+			_, _ = fmt.Fprintln(color.Output, color.YellowString("Warning: %s", warning))
+		}
+
+		_, _ = fmt.Fprint(color.Output, format.ColorFormatTree(configNode, true))
+
+		if onlineRes != nil {
+			printOnlineResult(*onlineRes)
+		}
 	}
 
-	if strings.Contains(errStr, "parse") || strings.Contains(errStr, "invalid") {
-		return "invalid URL format"
+	if onlineRes != nil && onlineRes.Supported && !onlineRes.Success {
+		os.Exit(cli.ExUnavailable)
+	}
+}
+
+func printOnlineResult(res onlineResult) {
+	switch {
+	case res.Success:
+		_, _ = fmt.Fprintln(color.Output, color.GreenString("Online verification succeeded"))
+	case !res.Supported:
+		_, _ = fmt.Fprintln(color.Output, color.YellowString(res.Error))
+	default:
+		_, _ = fmt.Fprintln(color.Output, color.RedString("Online verification failed: %s", res.Error))
 	}
-	// Fallback for other errors
-	return "unable to process URL"
 }
@@ -0,0 +1,72 @@
+package verify
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+)
+
+// onlineResult is the JSON output of the online verification.
+type onlineResult struct {
+	Supported bool   `json:"supported"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+// verifyOnline checks the service configuration against the remote API, if the service supports it.
+func verifyOnline(service types.Service) onlineResult {
+	verifier, ok := service.(types.Verifier)
+	if !ok {
+		return onlineResult{
+			Supported: false,
+			Error:     fmt.Sprintf("online verification is not supported by the %q service", service.GetID()),
+		}
+	}
+
+	if err := verifier.Verify(); err != nil {
+		err = format.RedactError(format.GetServiceConfig(service), err)
+
+		return onlineResult{Supported: true, Error: describeOnlineError(err)}
+	}
+
+	return onlineResult{Supported: true, Success: true}
+}
+
+// describeOnlineError returns the error message without any request URLs, since those commonly contain
+// tokens or other credentials, and labels certificate and handshake errors as TLS failures.
+func describeOnlineError(err error) string {
+	message := err.Error()
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		message = strings.ReplaceAll(message, urlErr.Error(), fmt.Sprintf("%s request failed: %v", urlErr.Op, urlErr.Err))
+	}
+
+	if isTLSError(err) {
+		return "TLS failure: " + message
+	}
+
+	return message
+}
+
+func isTLSError(err error) bool {
+	var (
+		verificationErr  *tls.CertificateVerificationError
+		recordHeaderErr  tls.RecordHeaderError
+		unknownAuthErr   x509.UnknownAuthorityError
+		hostnameErr      x509.HostnameError
+		certificateError x509.CertificateInvalidError
+	)
+
+	return errors.As(err, &verificationErr) ||
+		errors.As(err, &recordHeaderErr) ||
+		errors.As(err, &unknownAuthErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &certificateError)
+}
@@ -0,0 +1,92 @@
+package verify
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/nicholas-fedor/shoutrrr/pkg/format"
+	"github.com/nicholas-fedor/shoutrrr/pkg/types"
+	cli "github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
+)
+
+// parseParams parses the "key=value" param flag arguments.
+func parseParams(paramFlags []string) (types.Params, error) {
+	params := types.Params{}
+
+	for _, pair := range paramFlags {
+		key, value, err := cli.SplitKeyValue(pair)
+		if err != nil {
+			return nil, err
+		}
+
+		params[key] = value
+	}
+
+	return params, nil
+}
+
+// applyParams validates the params and overrides the config props of the service with them, the same way as
+// when sending. Params that the service uses without them being config props (like the message, or the params
+// that are passed on by the service) are accepted, but do not affect the config.
+func applyParams(service types.Service, params types.Params) error {
+	if _, err := cli.ValidateParams([]types.Service{service}, params); err != nil {
+		return err
+	}
+
+	config := format.GetServiceConfig(service)
+	resolver := format.GetConfigQueryResolver(config)
+	propKeys := resolver.QueryFields()
+
+	for key, value := range params {
+		if !slices.Contains(propKeys, strings.ToLower(key)) {
+			continue
+		}
+
+		if err := resolver.Set(key, value); err != nil {
+			return fmt.Errorf("invalid value for param %q: %w", key, err)
+		}
+	}
+
+	if err := format.ValidateConfig(config); err != nil {
+		return fmt.Errorf("invalid config after applying params: %w", err)
+	}
+
+	return nil
+}
+
+// getQueryWarnings returns a warning for each key in the service URL query that is not a config prop.
+func getQueryWarnings(serviceID string, config types.ServiceConfig, serviceURL *url.URL) []string {
+	if serviceURL == nil {
+		return nil
+	}
+
+	warnings := []string{}
+	webhookQuery := getWebhookQuery(config)
+
+	for _, key := range format.GetUnknownQueryKeys(config, serviceURL.Query()) {
+		if webhookQuery.Has(key) {
+			warnings = append(warnings, fmt.Sprintf("query key %q is not a config prop, and is passed on in the webhook URL", key))
+		} else {
+			warnings = append(warnings, fmt.Sprintf("query key %q is not a config prop of service %q, and is ignored", key, serviceID))
+		}
+	}
+
+	return warnings
+}
+
+// getWebhookQuery returns the query of the native webhook URL of the config, if it uses one.
+func getWebhookQuery(config types.ServiceConfig) url.Values {
+	webhookConfig, ok := config.(types.WebhookConfig)
+	if !ok {
+		return url.Values{}
+	}
+
+	webhookURL := webhookConfig.WebhookURL()
+	if webhookURL == nil {
+		return url.Values{}
+	}
+
+	return webhookURL.Query()
+}
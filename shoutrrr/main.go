@@ -5,6 +5,8 @@ import (
 
 	"github.com/nicholas-fedor/shoutrrr/internal/meta"
 	"github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd"
+	"github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd/convert"
+	"github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd/diff"
 	"github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd/docs"
 	"github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd/generate"
 	"github.com/nicholas-fedor/shoutrrr/shoutrrr/cmd/send"
@@ -25,6 +27,8 @@ func init() {
 	cobraCmd.AddCommand(generate.Cmd)
 	cobraCmd.AddCommand(send.Cmd)
 	cobraCmd.AddCommand(docs.Cmd)
+	cobraCmd.AddCommand(convert.Cmd)
+	cobraCmd.AddCommand(diff.Cmd)
 }
 
 func main() {